@@ -3,6 +3,7 @@ package feast
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"golang.org/x/oauth2"
@@ -11,24 +12,46 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
 )
 
-// Credential provides OIDC ID tokens used when authenticating with Feast.
+// Duration before a file token's expiry at which the token file is read again.
+const fileTokenExpiryDelta = time.Minute
+
+// Credential provides OIDC ID tokens or custom metadata headers used when authenticating with Feast.
 // Implements credentials.PerRPCCredentials
 type Credential struct {
-	tokenSrc oauth2.TokenSource
+	tokenSrc  oauth2.TokenSource
+	headerSrc HeaderSource
 }
 
-// GetRequestMetadata attaches OIDC token as metadata, refreshing tokens if required.
+// HeaderSource computes the metadata headers attached to a request.
+type HeaderSource func(ctx context.Context) (map[string]string, error)
+
+// GetRequestMetadata attaches OIDC token and custom headers as metadata, refreshing tokens if required.
 // This should be called by the GRPC to authenticate each request.
 func (provider *Credential) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
-	token, err := provider.tokenSrc.Token()
-	if err != nil {
-		return map[string]string{}, nil
+	metadata := map[string]string{}
+	if provider.tokenSrc != nil {
+		token, err := provider.tokenSrc.Token()
+		if err != nil {
+			return nil, err
+		}
+		metadata["Authorization"] = "Bearer " + token.AccessToken
+	}
+	if provider.headerSrc != nil {
+		headers, err := provider.headerSrc(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range headers {
+			metadata[key] = value
+		}
 	}
-	return map[string]string{
-		"Authorization": "Bearer " + token.AccessToken,
-	}, nil
+	return metadata, nil
 }
 
 // Disable requirement of transport security to allow user to configure it explictly instead.
@@ -45,6 +68,25 @@ func NewStaticCredential(token string) *Credential {
 	}
 }
 
+// Create a Header Credential that attaches the given static metadata headers, ie. an API key or tenant id.
+func NewHeaderCredential(headers map[string]string) *Credential {
+	return NewHeaderFuncCredential(func(ctx context.Context) (map[string]string, error) {
+		return headers, nil
+	})
+}
+
+// Create a Header Credential that attaches metadata headers computed by headerSrc on each request.
+func NewHeaderFuncCredential(headerSrc HeaderSource) *Credential {
+	return &Credential{headerSrc: headerSrc}
+}
+
+// Creates a new File Token Credential which reads a bearer token from the file at the given path.
+// Suited for projected service account tokens that are rotated on disk, ie. by the Kubernetes kubelet.
+// The token is cached until the file changes or, if the token is a JWT, its expiry draws near.
+func NewFileTokenCredential(path string) *Credential {
+	return &Credential{tokenSrc: &fileTokenSource{path: path}}
+}
+
 func newGoogleCredential(
 	audience string,
 	findDefaultCredentials func(ctx context.Context, scopes ...string) (*google.Credentials, error),
@@ -134,3 +176,71 @@ func (tokenSrc *oauthTokenSource) Token() (*oauth2.Token, error) {
 
 	return tokenSrc.token, nil
 }
+
+// Defines a Token Source that obtains tokens by reading them from a file.
+type fileTokenSource struct {
+	path    string
+	mu      sync.Mutex
+	modTime time.Time
+	token   *oauth2.Token
+}
+
+// Obtain or Reload token from File Token Source.
+// The token is read again if the file was modified or the cached token is about to expire.
+func (tokenSrc *fileTokenSource) Token() (*oauth2.Token, error) {
+	tokenSrc.mu.Lock()
+	defer tokenSrc.mu.Unlock()
+
+	info, err := os.Stat(tokenSrc.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file: %v", err)
+	}
+	nearExpiry := tokenSrc.token != nil && !tokenSrc.token.Expiry.IsZero() &&
+		time.Until(tokenSrc.token.Expiry) < fileTokenExpiryDelta
+	if tokenSrc.token == nil || nearExpiry || !info.ModTime().Equal(tokenSrc.modTime) {
+		tokenBytes, err := ioutil.ReadFile(tokenSrc.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read token file: %v", err)
+		}
+		accessToken := strings.TrimSpace(string(tokenBytes))
+		if accessToken == "" {
+			return nil, fmt.Errorf("token file %s is empty", tokenSrc.path)
+		}
+		// Tokens that are not JWTs are treated as never expiring.
+		expiry, _ := parseJWTExpiry(accessToken)
+		tokenSrc.token = &oauth2.Token{AccessToken: accessToken, Expiry: expiry}
+		tokenSrc.modTime = info.ModTime()
+	}
+
+	if !tokenSrc.token.Expiry.IsZero() && time.Now().After(tokenSrc.token.Expiry) {
+		return nil, fmt.Errorf("token in token file %s expired at %v", tokenSrc.path, tokenSrc.token.Expiry)
+	}
+	return tokenSrc.token, nil
+}
+
+// Parses the expiry time from the exp claim of the given JWT without verifying its signature.
+// Returns an error if the token is not a JWT or does not carry an exp claim.
+func parseJWTExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, err
+	}
+	claims := struct {
+		Expiry *json.Number `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, err
+	}
+	if claims.Expiry == nil {
+		return time.Time{}, fmt.Errorf("JWT does not have an exp claim")
+	}
+	exp, err := claims.Expiry.Float64()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(exp), 0), nil
+}
@@ -0,0 +1,190 @@
+// Package auth implements server side authentication of the bearer tokens sent by Feast clients.
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// Default interval after which the JWKS document is fetched again.
+	defaultRefreshInterval = time.Hour
+	// Default minimum interval between fetches of the JWKS document triggered by unknown key IDs.
+	defaultMinRefreshInterval = time.Minute
+	// Default timeout of fetches of the JWKS document.
+	defaultFetchTimeout = 10 * time.Second
+)
+
+// Signing algorithms accepted for bearer tokens. Symmetric algorithms are never accepted
+// as the keys come from a public JWKS document.
+var validMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// Config configures the validation of bearer tokens.
+type Config struct {
+	// URL of the JWKS document containing the public keys used to verify token signatures.
+	JWKSURL string
+	// Optional: Expected iss claim of tokens. Issuer is not checked if unspecified.
+	Issuer string
+	// Optional: Accepted aud claims, tokens must be issued for at least one of them.
+	// Audience is not checked if unspecified.
+	Audience []string
+	// Optional: Allowed clock skew when checking the exp, nbf and iat claims.
+	Leeway time.Duration
+	// Optional: Interval after which the JWKS document is fetched again. Defaults to 1 hour.
+	RefreshInterval time.Duration
+	// Optional: Minimum interval between fetches triggered by tokens signed with an unknown key. Defaults to 1 minute.
+	MinRefreshInterval time.Duration
+	// Optional: HTTP client used to fetch the JWKS document. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Optional: Timeout of fetches of the JWKS document, in addition to the deadline of the authenticated call.
+	// Defaults to 10 seconds.
+	FetchTimeout time.Duration
+	// Optional: Full gRPC method names, ie. "/grpc.health.v1.Health/Check", that do not require authentication.
+	UnauthenticatedMethods []string
+}
+
+// Authenticator validates bearer tokens against the keys of a JWKS document.
+type Authenticator struct {
+	config Config
+	keys   *keySet
+	parser *jwt.Parser
+}
+
+// NewAuthenticator creates a new Authenticator from the given config.
+// The JWKS document is fetched lazily on the first token validation.
+func NewAuthenticator(config Config) (*Authenticator, error) {
+	if config.JWKSURL == "" {
+		return nil, fmt.Errorf("JWKS URL must be provided")
+	}
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = defaultRefreshInterval
+	}
+	if config.MinRefreshInterval <= 0 {
+		config.MinRefreshInterval = defaultMinRefreshInterval
+	}
+	if config.FetchTimeout <= 0 {
+		config.FetchTimeout = defaultFetchTimeout
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	return &Authenticator{
+		config: config,
+		keys: &keySet{
+			url:                config.JWKSURL,
+			client:             config.HTTPClient,
+			fetchTimeout:       config.FetchTimeout,
+			refreshInterval:    config.RefreshInterval,
+			minRefreshInterval: config.MinRefreshInterval,
+		},
+		// Claims are validated by the Authenticator itself to account for leeway.
+		parser: jwt.NewParser(jwt.WithValidMethods(validMethods), jwt.WithJSONNumber(), jwt.WithoutClaimsValidation()),
+	}, nil
+}
+
+// Authenticate verifies the signature and claims of the given bearer token, returning its claims.
+// Fetches of the JWKS document required to verify the token are bounded by ctx.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*Claims, error) {
+	parsed, err := a.parser.Parse(token, func(t *jwt.Token) (interface{}, error) {
+		keyID, _ := t.Header["kid"].(string)
+		return a.keys.key(ctx, keyID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	raw, ok := parsed.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, fmt.Errorf("unexpected token claims")
+	}
+	claims, err := buildClaims(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validate(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// Validates the registered claims of a token against the config.
+func (a *Authenticator) validate(claims *Claims) error {
+	now := time.Now()
+	if claims.ExpiresAt.IsZero() {
+		return fmt.Errorf("token has no exp claim")
+	}
+	if now.After(claims.ExpiresAt.Add(a.config.Leeway)) {
+		return fmt.Errorf("token is expired")
+	}
+	if nbf, ok := numericDate(claims.Raw["nbf"]); ok && now.Add(a.config.Leeway).Before(nbf) {
+		return fmt.Errorf("token is not valid yet")
+	}
+	if !claims.IssuedAt.IsZero() && now.Add(a.config.Leeway).Before(claims.IssuedAt) {
+		return fmt.Errorf("token used before issued")
+	}
+	if a.config.Issuer != "" && claims.Issuer != a.config.Issuer {
+		return fmt.Errorf("token has invalid issuer '%s'", claims.Issuer)
+	}
+	if len(a.config.Audience) > 0 && !containsAny(claims.Audience, a.config.Audience) {
+		return fmt.Errorf("token has invalid audience %v", claims.Audience)
+	}
+	return nil
+}
+
+// Builds Claims from the raw claims of a token.
+func buildClaims(raw jwt.MapClaims) (*Claims, error) {
+	claims := &Claims{Raw: map[string]interface{}(raw)}
+	claims.Issuer, _ = raw["iss"].(string)
+	claims.Subject, _ = raw["sub"].(string)
+	claims.Audience = claims.Strings("aud")
+	var ok bool
+	if _, present := raw["exp"]; present {
+		if claims.ExpiresAt, ok = numericDate(raw["exp"]); !ok {
+			return nil, fmt.Errorf("token has invalid exp claim")
+		}
+	}
+	if _, present := raw["iat"]; present {
+		if claims.IssuedAt, ok = numericDate(raw["iat"]); !ok {
+			return nil, fmt.Errorf("token has invalid iat claim")
+		}
+	}
+	return claims, nil
+}
+
+// Converts a JSON numeric date claim into a time.
+func numericDate(value interface{}) (time.Time, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, int64(seconds*float64(time.Second))), true
+}
+
+func containsAny(values []string, wanted []string) bool {
+	for _, value := range values {
+		for _, w := range wanted {
+			if value == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Extracts the bearer token from the value of an authorization header.
+func bearerToken(header string) (string, bool) {
+	const prefix = "bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(header[len(prefix):]), true
+}
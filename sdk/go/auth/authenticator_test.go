@@ -0,0 +1,416 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// mockJWKSServer serves a JWKS document containing a mutable set of public keys.
+type mockJWKSServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	keys    map[string]crypto.PublicKey
+	fetches int
+}
+
+func newMockJWKSServer() *mockJWKSServer {
+	srv := &mockJWKSServer{keys: map[string]crypto.PublicKey{}}
+	srv.Server = httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+		srv.fetches++
+		encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+		keys := []map[string]string{}
+		for kid, key := range srv.keys {
+			switch key := key.(type) {
+			case *rsa.PublicKey:
+				keys = append(keys, map[string]string{
+					"kty": "RSA", "kid": kid, "use": "sig",
+					"n": encode(key.N.Bytes()), "e": encode(big.NewInt(int64(key.E)).Bytes()),
+				})
+			case *ecdsa.PublicKey:
+				keys = append(keys, map[string]string{
+					"kty": "EC", "kid": kid, "crv": key.Curve.Params().Name,
+					"x": encode(key.X.Bytes()), "y": encode(key.Y.Bytes()),
+				})
+			}
+		}
+		json.NewEncoder(resp).Encode(map[string]interface{}{"keys": keys})
+	}))
+	return srv
+}
+
+// Adds a new signing key with the given key ID to the served JWKS document.
+func (srv *mockJWKSServer) addKey(t *testing.T, kid string, keyType string) crypto.Signer {
+	var key crypto.Signer
+	var err error
+	if keyType == "RSA" {
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	} else {
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	srv.keys[kid] = key.Public()
+	return key
+}
+
+// Signs a token with the given claims and key.
+func signToken(t *testing.T, key crypto.Signer, kid string, claims jwt.MapClaims) string {
+	method := jwt.SigningMethod(jwt.SigningMethodES256)
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		method = jwt.SigningMethodRS256
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+// Returns claims valid for the test authenticator config.
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":    "https://issuer.example",
+		"sub":    "team-a-service",
+		"aud":    []string{"feast-serving"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"iat":    time.Now().Unix(),
+		"groups": []string{"team-a", "readers"},
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	srv := newMockJWKSServer()
+	defer srv.Close()
+	rsaKey := srv.addKey(t, "rsa", "RSA")
+	ecKey := srv.addKey(t, "ec", "EC")
+	unknownKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	authenticator, err := NewAuthenticator(Config{
+		JWKSURL:  srv.URL,
+		Issuer:   "https://issuer.example",
+		Audience: []string{"other", "feast-serving"},
+		Leeway:   time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	withClaim := func(name string, value interface{}) jwt.MapClaims {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	tt := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "Valid RSA signed token",
+			token: signToken(t, rsaKey, "rsa", validClaims()),
+		},
+		{
+			name:  "Valid ECDSA signed token",
+			token: signToken(t, ecKey, "ec", validClaims()),
+		},
+		{
+			name:  "Expired token within leeway",
+			token: signToken(t, rsaKey, "rsa", withClaim("exp", time.Now().Add(-30*time.Second).Unix())),
+		},
+		{
+			name:    "Expired token",
+			token:   signToken(t, rsaKey, "rsa", withClaim("exp", time.Now().Add(-time.Hour).Unix())),
+			wantErr: true,
+		},
+		{
+			name:    "Token without expiry",
+			token:   signToken(t, rsaKey, "rsa", withClaim("exp", nil)),
+			wantErr: true,
+		},
+		{
+			name:    "Token not valid yet",
+			token:   signToken(t, rsaKey, "rsa", withClaim("nbf", time.Now().Add(time.Hour).Unix())),
+			wantErr: true,
+		},
+		{
+			name:    "Wrong issuer",
+			token:   signToken(t, rsaKey, "rsa", withClaim("iss", "https://evil.example")),
+			wantErr: true,
+		},
+		{
+			name:    "Wrong audience",
+			token:   signToken(t, rsaKey, "rsa", withClaim("aud", "feast-core")),
+			wantErr: true,
+		},
+		{
+			name:    "Signed by unknown key",
+			token:   signToken(t, unknownKey, "rsa", validClaims()),
+			wantErr: true,
+		},
+		{
+			name: "Symmetric signing algorithm",
+			token: func() string {
+				s, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString([]byte("secret"))
+				return s
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "Malformed token",
+			token:   "not.a.token",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := authenticator.Authenticate(context.Background(), tc.token)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if claims.Subject != "team-a-service" {
+				t.Errorf("Expected subject 'team-a-service', got '%s'", claims.Subject)
+			}
+			if groups := claims.Strings("groups"); len(groups) != 2 || groups[0] != "team-a" {
+				t.Errorf("Expected groups [team-a readers], got %v", groups)
+			}
+		})
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	srv := newMockJWKSServer()
+	defer srv.Close()
+	oldKey := srv.addKey(t, "old", "RSA")
+
+	authenticator, err := NewAuthenticator(Config{JWKSURL: srv.URL, MinRefreshInterval: time.Nanosecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := authenticator.Authenticate(ctx, signToken(t, oldKey, "old", validClaims())); err != nil {
+		t.Fatalf("Unexpected error authenticating: %v", err)
+	}
+
+	// Tokens signed by a rotated key should trigger a refetch of the JWKS document.
+	newKey := srv.addKey(t, "new", "EC")
+	if _, err := authenticator.Authenticate(ctx, signToken(t, newKey, "new", validClaims())); err != nil {
+		t.Fatalf("Unexpected error authenticating with rotated key: %v", err)
+	}
+	if _, err := authenticator.Authenticate(ctx, signToken(t, oldKey, "old", validClaims())); err != nil {
+		t.Fatalf("Unexpected error authenticating with old key: %v", err)
+	}
+	if srv.fetches != 2 {
+		t.Errorf("Expected JWKS to be fetched 2 times, got %d", srv.fetches)
+	}
+}
+
+func TestParseJWKSMixedCurves(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	document, err := json.Marshal(map[string]interface{}{"keys": []map[string]string{
+		{"kty": "EC", "kid": "secp256k1", "crv": "secp256k1", "x": encode(key.X.Bytes()), "y": encode(key.Y.Bytes())},
+		{"kty": "OKP", "kid": "ed25519", "crv": "Ed25519", "x": encode(key.X.Bytes())},
+		{"kty": "EC", "kid": "p256", "crv": "P-256", "x": encode(key.X.Bytes()), "y": encode(key.Y.Bytes())},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Keys on unsupported curves are skipped, without rejecting the keys that can be used.
+	keys, err := parseJWKS(document)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing JWKS: %v", err)
+	}
+	if len(keys) != 1 || keys["p256"] == nil {
+		t.Errorf("Expected only the P-256 key, got %v", keys)
+	}
+}
+
+func TestSlowJWKSEndpoint(t *testing.T) {
+	srv := newMockJWKSServer()
+	defer srv.Close()
+	key := srv.addKey(t, "rsa", "RSA")
+	var mu sync.Mutex
+	blocked := false
+	requests, release := make(chan struct{}, 1), make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		block := blocked
+		mu.Unlock()
+		if block {
+			requests <- struct{}{}
+			select {
+			case <-release:
+			case <-req.Context().Done():
+				return
+			}
+		}
+		srv.Config.Handler.ServeHTTP(resp, req)
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	authenticator, err := NewAuthenticator(Config{JWKSURL: slow.URL, MinRefreshInterval: time.Nanosecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := authenticator.Authenticate(ctx, signToken(t, key, "rsa", validClaims())); err != nil {
+		t.Fatalf("Unexpected error authenticating: %v", err)
+	}
+
+	// A token signed by an unknown key triggers a fetch, which hangs until the deadline of the call.
+	mu.Lock()
+	blocked = true
+	mu.Unlock()
+	unknownKey := srv.addKey(t, "unknown", "EC")
+	failed := make(chan error)
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+		_, err := authenticator.Authenticate(ctx, signToken(t, unknownKey, "unknown", validClaims()))
+		failed <- err
+	}()
+	<-requests
+
+	// Tokens signed by cached keys are not blocked by the fetch in progress.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	if _, err := authenticator.Authenticate(timeoutCtx, signToken(t, key, "rsa", validClaims())); err != nil {
+		t.Fatalf("Unexpected error authenticating during a fetch: %v", err)
+	}
+	if err := <-failed; err == nil {
+		t.Error("Expected an error authenticating while the JWKS endpoint hangs")
+	}
+}
+
+func TestServerInterceptors(t *testing.T) {
+	srv := newMockJWKSServer()
+	defer srv.Close()
+	key := srv.addKey(t, "rsa", "RSA")
+	authenticator, err := NewAuthenticator(Config{
+		JWKSURL:                srv.URL,
+		UnauthenticatedMethods: []string{"/grpc.health.v1.Health/Check"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := signToken(t, key, "rsa", validClaims())
+
+	tt := []struct {
+		name       string
+		method     string
+		metadata   metadata.MD
+		wantCode   codes.Code
+		wantClaims bool
+	}{
+		{
+			name:       "Valid bearer token",
+			method:     "/feast.serving.ServingService/GetOnlineFeatures",
+			metadata:   metadata.Pairs("authorization", "Bearer "+token),
+			wantCode:   codes.OK,
+			wantClaims: true,
+		},
+		{
+			name:     "Missing authorization metadata",
+			method:   "/feast.serving.ServingService/GetOnlineFeatures",
+			metadata: metadata.MD{},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Not a bearer token",
+			method:   "/feast.serving.ServingService/GetOnlineFeatures",
+			metadata: metadata.Pairs("authorization", "Basic dXNlcjpwYXNz"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Invalid bearer token",
+			method:   "/feast.serving.ServingService/GetOnlineFeatures",
+			metadata: metadata.Pairs("authorization", "Bearer invalid"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Unauthenticated method",
+			method:   "/grpc.health.v1.Health/Check",
+			metadata: metadata.MD{},
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tc.metadata)
+
+			// Unary interceptor
+			_, err := authenticator.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					if _, ok := ClaimsFromContext(ctx); ok != tc.wantClaims {
+						t.Errorf("Expected claims on context: %v", tc.wantClaims)
+					}
+					return nil, nil
+				})
+			if got := status.Code(err); got != tc.wantCode {
+				t.Errorf("Expected unary status code %v, got %v", tc.wantCode, got)
+			}
+
+			// Stream interceptor
+			err = authenticator.StreamServerInterceptor()(nil, &mockServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: tc.method},
+				func(srv interface{}, stream grpc.ServerStream) error {
+					if _, ok := ClaimsFromContext(stream.Context()); ok != tc.wantClaims {
+						t.Errorf("Expected claims on stream context: %v", tc.wantClaims)
+					}
+					return nil
+				})
+			if got := status.Code(err); got != tc.wantCode {
+				t.Errorf("Expected stream status code %v, got %v", tc.wantCode, got)
+			}
+		})
+	}
+}
+
+// mockServerStream is a grpc.ServerStream with a fixed context.
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// Claims are the verified claims of a bearer token.
+type Claims struct {
+	// Issuer is the iss claim of the token.
+	Issuer string
+	// Subject is the sub claim of the token.
+	Subject string
+	// Audience is the aud claim of the token.
+	Audience []string
+	// ExpiresAt is the time given by the exp claim of the token.
+	ExpiresAt time.Time
+	// IssuedAt is the time given by the iat claim of the token, zero if not present.
+	IssuedAt time.Time
+	// Raw contains all claims of the token, including non registered claims such as groups or email.
+	Raw map[string]interface{}
+}
+
+// Strings returns the values of the claim with the given name, which may either be a single string
+// or a list of strings (ie. a groups claim). Returns nil if the claim is absent or has another type.
+func (c *Claims) Strings(name string) []string {
+	switch value := c.Raw[name].(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return value
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+type claimsKey struct{}
+
+// NewContextWithClaims returns a new context carrying the given verified claims.
+func NewContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the verified claims put on the context by the server interceptors.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
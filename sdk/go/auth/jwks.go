@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// jsonWebKey is a single public key in a JWKS document as defined by RFC 7517.
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	// RSA public key parameters.
+	N string `json:"n"`
+	E string `json:"e"`
+	// ECDSA public key parameters.
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+// keySet caches the public keys of a JWKS document, fetching it again when the refresh interval
+// elapses or a token is signed by an unknown key, ie. after the issuer rotated its keys.
+type keySet struct {
+	url                string
+	client             *http.Client
+	fetchTimeout       time.Duration
+	refreshInterval    time.Duration
+	minRefreshInterval time.Duration
+
+	mu        sync.Mutex
+	keys      map[string]interface{}
+	fetchedAt time.Time
+	// Fetch of the JWKS document in progress, nil if there is none.
+	fetching *keyFetch
+}
+
+// keyFetch is a fetch of the JWKS document shared by the callers needing it.
+type keyFetch struct {
+	// Closed once the fetch completed and the cached keys were updated.
+	done chan struct{}
+	err  error
+}
+
+// Returns the public key with the given key ID, fetching the JWKS document if required.
+// An empty key ID selects the only key in the key set. Concurrent callers share a single fetch, which is
+// not waited for by callers that already have their key cached.
+func (ks *keySet) key(ctx context.Context, keyID string) (interface{}, error) {
+	ks.mu.Lock()
+	stale := ks.keys == nil || time.Since(ks.fetchedAt) > ks.refreshInterval
+	key, found := ks.lookup(keyID)
+	// Unknown keys trigger a refresh to pick up rotated keys, but at most every minRefreshInterval
+	// to avoid tokens with bogus key IDs hammering the JWKS endpoint.
+	refresh := stale || (!found && time.Since(ks.fetchedAt) > ks.minRefreshInterval)
+	fetch := ks.fetching
+	if !refresh || (fetch != nil && found) {
+		ks.mu.Unlock()
+		return foundKey(key, found, keyID)
+	}
+	if fetch == nil {
+		fetch = &keyFetch{done: make(chan struct{})}
+		ks.fetching = fetch
+		ks.fetchedAt = time.Now()
+		ks.mu.Unlock()
+		ks.refresh(ctx, fetch)
+	} else {
+		ks.mu.Unlock()
+		select {
+		case <-fetch.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	if ks.keys == nil {
+		return nil, fetch.err
+	}
+	key, found = ks.lookup(keyID)
+	return foundKey(key, found, keyID)
+}
+
+// Returns the key if it was found, or an error naming the key ID otherwise.
+func foundKey(key interface{}, found bool, keyID string) (interface{}, error) {
+	if !found {
+		return nil, fmt.Errorf("no key found in JWKS for key ID '%s'", keyID)
+	}
+	return key, nil
+}
+
+func (ks *keySet) lookup(keyID string) (interface{}, bool) {
+	if keyID == "" && len(ks.keys) == 1 {
+		for _, key := range ks.keys {
+			return key, true
+		}
+	}
+	key, ok := ks.keys[keyID]
+	return key, ok
+}
+
+// Runs the given fetch of the JWKS document, replacing the cached keys. Keeps the cached keys if the fetch fails.
+func (ks *keySet) refresh(ctx context.Context, fetch *keyFetch) {
+	keys, err := ks.fetch(ctx)
+	ks.mu.Lock()
+	if err == nil {
+		ks.keys = keys
+	}
+	fetch.err = err
+	ks.fetching = nil
+	ks.mu.Unlock()
+	close(fetch.done)
+}
+
+// Fetches and parses the JWKS document, giving up after the fetch timeout.
+func (ks *keySet) fetch(ctx context.Context) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, ks.fetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %v", err)
+	}
+	resp, err := ks.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned unexpected status: %s", resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %v", err)
+	}
+	return parseJWKS(body)
+}
+
+// Parses the signature verification keys of a JWKS document into a map of key ID to public key.
+// Keys with unsupported key types or curves, or intended for encryption, are skipped.
+func parseJWKS(document []byte) (map[string]interface{}, error) {
+	jwks := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	if err := json.Unmarshal(document, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %v", err)
+	}
+	keys := make(map[string]interface{}, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		var key interface{}
+		var err error
+		switch jwk.KeyType {
+		case "RSA":
+			key, err = jwk.rsaPublicKey()
+		case "EC":
+			curve, ok := ecdsaCurve(jwk.Curve)
+			if !ok {
+				continue
+			}
+			key, err = jwk.ecdsaPublicKey(curve)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWKS key '%s': %v", jwk.KeyID, err)
+		}
+		keys[jwk.KeyID] = key
+	}
+	return keys, nil
+}
+
+func (jwk jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := decodeBigInt(jwk.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeBigInt(jwk.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() {
+		return nil, fmt.Errorf("RSA exponent too large")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+// Returns the elliptic curve with the given JWK name, if supported.
+func ecdsaCurve(name string) (elliptic.Curve, bool) {
+	switch name {
+	case "P-256":
+		return elliptic.P256(), true
+	case "P-384":
+		return elliptic.P384(), true
+	case "P-521":
+		return elliptic.P521(), true
+	default:
+		return nil, false
+	}
+}
+
+func (jwk jsonWebKey) ecdsaPublicKey(curve elliptic.Curve) (*ecdsa.PublicKey, error) {
+	x, err := decodeBigInt(jwk.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBigInt(jwk.Y)
+	if err != nil {
+		return nil, err
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("point is not on curve '%s'", jwk.Curve)
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// Decodes a base64url encoded big endian integer.
+func decodeBigInt(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, fmt.Errorf("missing key parameter")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,221 @@
+package feast
+
+import (
+	"crypto"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// Default lifetime of JWTs signed by private key JWT credentials.
+	defaultJWTLifetime = time.Hour
+	// Lifetime of RFC 7523 client assertions, which are only used once to make a token request.
+	clientAssertionLifetime = 5 * time.Minute
+	// Client assertion type for RFC 7523 JWT bearer client assertions.
+	jwtBearerAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+)
+
+// PrivateKeyJWTConfig configures a credential that authenticates with short-lived JWTs signed by a private key.
+type PrivateKeyJWTConfig struct {
+	// PEM encoded RSA or ECDSA private key used to sign JWTs.
+	PrivateKey []byte
+	// Optional: Key ID set as the kid header of signed JWTs to select the verification key.
+	KeyID string
+	// Issuer set as the iss claim of signed JWTs.
+	Issuer string
+	// Optional: Subject set as the sub claim of signed JWTs. Defaults to the Issuer if unspecified.
+	Subject string
+	// Audience set as the aud claim of signed JWTs.
+	Audience string
+	// Optional: Lifetime of signed JWTs. Defaults to 1 hour if unspecified.
+	Lifetime time.Duration
+}
+
+// Creates a new Private Key JWT Credential which authenticates with self-signed JWT bearer tokens.
+// Tokens are signed with RS256 for RSA keys or ES256/ES384/ES512 for ECDSA keys depending on the curve.
+func NewPrivateKeyJWTCredential(config PrivateKeyJWTConfig) (*Credential, error) {
+	signer, err := newJWTSigner(config.PrivateKey, config.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if config.Issuer == "" || config.Audience == "" {
+		return nil, fmt.Errorf("issuer and audience must be provided to sign JWTs")
+	}
+	subject := config.Subject
+	if subject == "" {
+		subject = config.Issuer
+	}
+	lifetime := config.Lifetime
+	if lifetime <= 0 {
+		lifetime = defaultJWTLifetime
+	}
+	tokenSrc := &jwtTokenSource{
+		signer:   signer,
+		issuer:   config.Issuer,
+		subject:  subject,
+		audience: config.Audience,
+		lifetime: lifetime,
+	}
+	return &Credential{tokenSrc: oauth2.ReuseTokenSource(nil, tokenSrc)}, nil
+}
+
+// Creates a new OAuth credential which obtains credentials by making a client credentials request
+// authenticated with a RFC 7523 JWT client assertion signed by the client's private key.
+// audience - audience of the requested access token.
+// clientId - ID of the client, used as the issuer and subject of the client assertion.
+// privateKey, keyID - PEM encoded RSA or ECDSA private key used to sign the client assertion and its optional key ID.
+// endpointURL - target URL of the OAuth token endpoint, used as the audience of the client assertion.
+func NewOAuthClientAssertionCredential(audience string, clientId string, privateKey []byte, keyID string, endpointURL *url.URL) (*Credential, error) {
+	signer, err := newJWTSigner(privateKey, keyID)
+	if err != nil {
+		return nil, err
+	}
+	tokenSrc := &oauthAssertionTokenSource{
+		assertionSrc: &jwtTokenSource{
+			signer:   signer,
+			issuer:   clientId,
+			subject:  clientId,
+			audience: endpointURL.String(),
+			lifetime: clientAssertionLifetime,
+		},
+		endpointURL: endpointURL,
+		audience:    audience,
+	}
+	return &Credential{tokenSrc: oauth2.ReuseTokenSource(nil, tokenSrc)}, nil
+}
+
+// jwtSigner signs JWTs with a private key.
+type jwtSigner struct {
+	method jwt.SigningMethod
+	key    crypto.Signer
+	keyID  string
+}
+
+// Creates a JWT signer from the given PEM encoded RSA or ECDSA private key.
+func newJWTSigner(privateKey []byte, keyID string) (*jwtSigner, error) {
+	if rsaKey, err := jwt.ParseRSAPrivateKeyFromPEM(privateKey); err == nil {
+		return &jwtSigner{method: jwt.SigningMethodRS256, key: rsaKey, keyID: keyID}, nil
+	}
+	ecKey, err := jwt.ParseECPrivateKeyFromPEM(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("private key must be a PEM encoded RSA or ECDSA private key")
+	}
+	var method jwt.SigningMethod
+	switch ecKey.Curve.Params().BitSize {
+	case 256:
+		method = jwt.SigningMethodES256
+	case 384:
+		method = jwt.SigningMethodES384
+	case 521:
+		method = jwt.SigningMethodES512
+	default:
+		return nil, fmt.Errorf("unsupported ECDSA curve: %s", ecKey.Curve.Params().Name)
+	}
+	return &jwtSigner{method: method, key: ecKey, keyID: keyID}, nil
+}
+
+// Signs a JWT carrying the given claims.
+func (signer *jwtSigner) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(signer.method, claims)
+	if signer.keyID != "" {
+		token.Header["kid"] = signer.keyID
+	}
+	return token.SignedString(signer.key)
+}
+
+// Defines a Token Source that obtains tokens by signing short-lived JWTs.
+type jwtTokenSource struct {
+	signer   *jwtSigner
+	issuer   string
+	subject  string
+	audience string
+	lifetime time.Duration
+}
+
+// Sign a new JWT valid for the lifetime of the Token Source.
+func (tokenSrc *jwtTokenSource) Token() (*oauth2.Token, error) {
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	expiry := now.Add(tokenSrc.lifetime)
+	signed, err := tokenSrc.signer.sign(jwt.RegisteredClaims{
+		Issuer:    tokenSrc.issuer,
+		Subject:   tokenSrc.subject,
+		Audience:  jwt.ClaimStrings{tokenSrc.audience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiry),
+		ID:        hex.EncodeToString(jti),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{AccessToken: signed, Expiry: expiry}, nil
+}
+
+// Defines a Token Source that obtains tokens via making a OAuth client credentials request
+// authenticated with a JWT client assertion.
+type oauthAssertionTokenSource struct {
+	assertionSrc oauth2.TokenSource
+	endpointURL  *url.URL
+	audience     string
+}
+
+// Defines a OAuth token response.
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Obtain a new token from the OAuth Token Source.
+func (tokenSrc *oauthAssertionTokenSource) Token() (*oauth2.Token, error) {
+	assertion, err := tokenSrc.assertionSrc.Token()
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":            {"client_credentials"},
+		"client_assertion_type": {jwtBearerAssertionType},
+		"client_assertion":      {assertion.AccessToken},
+	}
+	if tokenSrc.audience != "" {
+		form.Set("audience", tokenSrc.audience)
+	}
+	resp, err := http.Post(tokenSrc.endpointURL.String(),
+		"application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OAuth Endpoint returned unexpected status: %s", resp.Status)
+	}
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	tokenResp := oauthTokenResponse{}
+	if err := json.Unmarshal(respBytes, &tokenResp); err != nil {
+		return nil, err
+	}
+	if tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("OAuth Endpoint returned no access token")
+	}
+	token := &oauth2.Token{AccessToken: tokenResp.AccessToken, TokenType: tokenResp.TokenType}
+	if tokenResp.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
@@ -0,0 +1,212 @@
+package feast
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Generates a PEM encoded private key of the given type for use in tests.
+func mockPrivateKey(t *testing.T, keyType string) (crypto.Signer, []byte) {
+	switch keyType {
+	case "RSA":
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		der := x509.MarshalPKCS1PrivateKey(key)
+		return key, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: der})
+	default:
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		der, _ := x509.MarshalECPrivateKey(key)
+		return key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	}
+}
+
+// Parses and verifies the given JWT with the public key of the given private key.
+func verifyMockJWT(t *testing.T, signed string, key crypto.Signer) (*jwt.Token, *jwt.RegisteredClaims) {
+	claims := &jwt.RegisteredClaims{}
+	token, err := jwt.ParseWithClaims(signed, claims, func(*jwt.Token) (interface{}, error) {
+		return key.Public(), nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to verify signed JWT: %v", err)
+	}
+	return token, claims
+}
+
+func TestPrivateKeyJWTCredential(t *testing.T) {
+	for _, keyType := range []string{"RSA", "ECDSA"} {
+		t.Run(keyType, func(t *testing.T) {
+			key, keyPEM := mockPrivateKey(t, keyType)
+			credential, err := NewPrivateKeyJWTCredential(PrivateKeyJWTConfig{
+				PrivateKey: keyPEM,
+				KeyID:      "key-1",
+				Issuer:     "feast-client",
+				Audience:   "feast-serving",
+				Lifetime:   10 * time.Minute,
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error creating credential: %v", err)
+			}
+
+			meta, err := credential.GetRequestMetadata(context.Background(), "feast.serving")
+			if err != nil {
+				t.Fatal(err)
+			}
+			signed := strings.TrimPrefix(meta["Authorization"], "Bearer ")
+			token, claims := verifyMockJWT(t, signed, key)
+			if token.Header["kid"] != "key-1" {
+				t.Errorf("Expected kid header 'key-1', got '%v'", token.Header["kid"])
+			}
+			if claims.Issuer != "feast-client" || claims.Subject != "feast-client" {
+				t.Errorf("Expected issuer and subject 'feast-client', got '%s' and '%s'", claims.Issuer, claims.Subject)
+			}
+			if !claims.VerifyAudience("feast-serving", true) {
+				t.Errorf("Expected audience 'feast-serving', got %v", claims.Audience)
+			}
+			if lifetime := claims.ExpiresAt.Sub(claims.IssuedAt.Time); lifetime != 10*time.Minute {
+				t.Errorf("Expected token lifetime of 10m, got %v", lifetime)
+			}
+
+			// Token should be reused while it's valid.
+			again, _ := credential.GetRequestMetadata(context.Background(), "feast.serving")
+			if again["Authorization"] != meta["Authorization"] {
+				t.Error("Expected signed token to be reused")
+			}
+		})
+	}
+}
+
+func TestInvalidPrivateKeyJWTCredential(t *testing.T) {
+	_, keyPEM := mockPrivateKey(t, "ECDSA")
+	tt := []struct {
+		name   string
+		config PrivateKeyJWTConfig
+	}{
+		{
+			name:   "Invalid private key",
+			config: PrivateKeyJWTConfig{PrivateKey: []byte("not a key"), Issuer: "iss", Audience: "aud"},
+		},
+		{
+			name:   "Missing audience",
+			config: PrivateKeyJWTConfig{PrivateKey: keyPEM, Issuer: "iss"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewPrivateKeyJWTCredential(tc.config); err == nil {
+				t.Error("Expected error creating credential")
+			}
+		})
+	}
+}
+
+func TestOAuthClientAssertionCredential(t *testing.T) {
+	key, keyPEM := mockPrivateKey(t, "RSA")
+	clientId := "client"
+	audience := "localhost"
+
+	// Create a mock OAuth server that verifies the client assertion.
+	var endpoint string
+	srv := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		if err := req.ParseForm(); err != nil {
+			resp.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if req.PostForm.Get("grant_type") != "client_credentials" ||
+			req.PostForm.Get("client_assertion_type") != jwtBearerAssertionType ||
+			req.PostForm.Get("audience") != audience {
+			resp.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, claims := verifyMockJWT(t, req.PostForm.Get("client_assertion"), key)
+		if claims.Issuer != clientId || claims.Subject != clientId || !claims.VerifyAudience(endpoint, true) {
+			resp.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(resp, `{"access_token": "oauth token", "token_type": "Bearer", "expires_in": 3600}`)
+	}))
+	defer srv.Close()
+	endpoint = srv.URL + "/oauth/token"
+	endpointURL, _ := url.Parse(endpoint)
+
+	credential, err := NewOAuthClientAssertionCredential(audience, clientId, keyPEM, "", endpointURL)
+	if err != nil {
+		t.Fatalf("Unexpected error creating credential: %v", err)
+	}
+	meta, err := credential.GetRequestMetadata(context.Background(), "feast.serving")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta["Authorization"] != "Bearer oauth token" {
+		t.Errorf("Expected authentication metadata with value: 'Bearer oauth token' Got instead: '%s'", meta["Authorization"])
+	}
+}
+
+func TestHeaderCredential(t *testing.T) {
+	type tenantKey struct{}
+	tt := []struct {
+		name       string
+		credential *Credential
+		ctx        context.Context
+		want       map[string]string
+		wantErr    bool
+	}{
+		{
+			name:       "Static headers",
+			credential: NewHeaderCredential(map[string]string{"x-api-key": "secret"}),
+			ctx:        context.Background(),
+			want:       map[string]string{"x-api-key": "secret"},
+		},
+		{
+			name: "Per call headers",
+			credential: NewHeaderFuncCredential(func(ctx context.Context) (map[string]string, error) {
+				return map[string]string{"x-tenant-id": ctx.Value(tenantKey{}).(string)}, nil
+			}),
+			ctx:  context.WithValue(context.Background(), tenantKey{}, "team-a"),
+			want: map[string]string{"x-tenant-id": "team-a"},
+		},
+		{
+			name: "Failing header source",
+			credential: NewHeaderFuncCredential(func(ctx context.Context) (map[string]string, error) {
+				return nil, fmt.Errorf("vault unavailable")
+			}),
+			ctx:     context.Background(),
+			wantErr: true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.credential.GetRequestMetadata(tc.ctx, "feast.serving")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			for key, value := range tc.want {
+				if got[key] != value {
+					t.Errorf("Expected header %s with value '%s', got '%s'", key, value, got[key])
+				}
+			}
+			if _, ok := got["Authorization"]; ok {
+				t.Error("Expected no Authorization header")
+			}
+		})
+	}
+}
@@ -2,13 +2,17 @@ package feast
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
@@ -140,3 +144,74 @@ func TestCredentials(t *testing.T) {
 		})
 	}
 }
+
+// Creates an unsigned JWT with the given expiry for use as a projected service account token.
+func mockJWT(subject string, expiry time.Time) string {
+	encode := func(v interface{}) string {
+		b, _ := json.Marshal(v)
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	header := encode(map[string]string{"alg": "RS256", "typ": "JWT"})
+	payload := encode(map[string]interface{}{"sub": subject, "exp": expiry.Unix()})
+	return header + "." + payload + ".signature"
+}
+
+func TestFileTokenCredential(t *testing.T) {
+	dir, err := ioutil.TempDir("", "feast-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token")
+	credential := NewFileTokenCredential(path)
+	ctx := context.Background()
+
+	writeToken := func(token string, modTime time.Time) {
+		if err := ioutil.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wantToken := func(want string) {
+		meta, err := credential.GetRequestMetadata(ctx, "feast.serving")
+		if err != nil {
+			t.Fatalf("Unexpected error getting authentication metadata: %v", err)
+		}
+		if meta["Authorization"] != "Bearer "+want {
+			t.Errorf("Expected authentication metadata with value: 'Bearer %s' Got instead: '%s'", want, meta["Authorization"])
+		}
+	}
+
+	// Missing token file should fail instead of sending no credentials.
+	if _, err := credential.GetRequestMetadata(ctx, "feast.serving"); err == nil {
+		t.Error("Expected error getting authentication metadata from missing token file")
+	}
+
+	now := time.Now()
+	first := mockJWT("first", now.Add(time.Hour))
+	writeToken(first, now)
+	wantToken(first)
+
+	// Token expiry should be parsed from the exp claim.
+	src := credential.tokenSrc.(*fileTokenSource)
+	if !src.token.Expiry.Equal(time.Unix(now.Add(time.Hour).Unix(), 0)) {
+		t.Errorf("Expected token expiry to be parsed from JWT, got %v", src.token.Expiry)
+	}
+
+	// Rotated token should be picked up once the file changes.
+	second := mockJWT("second", now.Add(2*time.Hour))
+	writeToken(second, now.Add(time.Second))
+	wantToken(second)
+
+	// Opaque tokens are never considered expired.
+	writeToken("opaque", now.Add(2*time.Second))
+	wantToken("opaque")
+
+	// Expired tokens should fail.
+	writeToken(mockJWT("expired", now.Add(-time.Minute)), now.Add(3*time.Second))
+	if _, err := credential.GetRequestMetadata(ctx, "feast.serving"); err == nil {
+		t.Error("Expected error getting authentication metadata from expired token")
+	}
+}
@@ -2,8 +2,8 @@ package feast
 
 import (
 	"context"
-	"crypto/x509"
 	"fmt"
+	"time"
 
 	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
 	"github.com/opentracing-contrib/go-grpc"
@@ -22,19 +22,44 @@ type Client interface {
 
 // GrpcClient is a grpc client for feast serving.
 type GrpcClient struct {
-	cli  serving.ServingServiceClient
-	conn *grpc.ClientConn
+	cli        serving.ServingServiceClient
+	conn       *grpc.ClientConn
+	authorizer RequestAuthorizer
 }
 
+// RequestAuthorizer checks whether an online features request may be sent before it is sent to Feast serving.
+// Returning an error aborts the request with that error.
+type RequestAuthorizer func(ctx context.Context, req *OnlineFeaturesRequest) error
+
 // SecurityConfig wraps security config for GrpcClient
 type SecurityConfig struct {
 	// Whether to enable TLS SSL trasnport security if true.
 	EnableTLS bool
 	// Optional: Provides path to TLS certificate used the verify Service identity.
 	TLSCertPath string
+	// Optional: PEM encoded TLS certificate used to verify Service identity.
+	// Takes precedence over TLSCertPath if specified.
+	TLSCert []byte
+	// Optional: Provides paths to the TLS client certificate and key used for mutual TLS.
+	TLSClientCertPath string
+	TLSClientKeyPath  string
+	// Optional: PEM encoded TLS client certificate and key used for mutual TLS.
+	// Takes precedence over TLSClientCertPath and TLSClientKeyPath if specified.
+	TLSClientCert []byte
+	TLSClientKey  []byte
+	// Optional: Overrides the server name used to verify the Service's certificate.
+	TLSServerName string
+	// Optional: Minimum TLS version to accept, ie tls.VersionTLS12. Uses Go's default if unspecified.
+	TLSMinVersion uint16
+	// Optional: Interval at which certificates given as paths are checked for changes and reloaded.
+	// Disables reloading if unspecified.
+	TLSReloadInterval time.Duration
 	// Optional: Credential used for authentication.
 	// Disables authentication if unspecified.
 	Credential *Credential
+	// Optional: Authorizer used to check requests client side before they are sent.
+	// Disables client side authorization checks if unspecified.
+	Authorizer RequestAuthorizer
 }
 
 // NewGrpcClient constructs a client that can interact via grpc with the feast serving instance at the given host:port.
@@ -59,7 +84,19 @@ func NewSecureGrpcClient(host string, port int, security SecurityConfig) (*GrpcC
 // securityConfig - security config configures client security.
 // opts - grpc.DialOptions which should be used with this connection
 func NewSecureGrpcClientWithDialOptions(host string, port int, security SecurityConfig, opts ...grpc.DialOption) (*GrpcClient, error) {
-	feastCli := &GrpcClient{}
+	conn, err := dial(host, port, security, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &GrpcClient{
+		cli:        serving.NewServingServiceClient(conn),
+		conn:       conn,
+		authorizer: security.Authorizer,
+	}, nil
+}
+
+// Dials the feast service at the given host:port, configuring TLS, authentication and tracing from the security config.
+func dial(host string, port int, security SecurityConfig, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	adr := fmt.Sprintf("%s:%d", host, port)
 
 	// Compile grpc dial options from security config.
@@ -67,21 +104,12 @@ func NewSecureGrpcClientWithDialOptions(host string, port int, security Security
 	// Configure client TLS.
 	if !security.EnableTLS {
 		options = append(options, grpc.WithInsecure())
-	} else if security.EnableTLS && security.TLSCertPath != "" {
-		// Read TLS certificate from given path.
-		tlsCreds, err := credentials.NewClientTLSFromFile(security.TLSCertPath, "")
-		if err != nil {
-			return nil, err
-		}
-		options = append(options, grpc.WithTransportCredentials(tlsCreds))
 	} else {
-		// Use system TLS certificate pool.
-		certPool, err := x509.SystemCertPool()
+		tlsConfig, err := security.buildTLSConfig(host)
 		if err != nil {
 			return nil, err
 		}
-		tlsCreds := credentials.NewClientTLSFromCert(certPool, "")
-		options = append(options, grpc.WithTransportCredentials(tlsCreds))
+		options = append(options, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	}
 
 	// Enable authentication by attaching credentials if given
@@ -94,13 +122,7 @@ func NewSecureGrpcClientWithDialOptions(host string, port int, security Security
 		otgrpc.OpenTracingClientInterceptor(opentracing.GlobalTracer()))
 	options = append(options, tracingInterceptor)
 
-	conn, err := grpc.Dial(adr, options...)
-	if err != nil {
-		return nil, err
-	}
-	feastCli.cli = serving.NewServingServiceClient(conn)
-	feastCli.conn = conn
-	return feastCli, nil
+	return grpc.Dial(adr, options...)
 }
 
 // GetOnlineFeatures gets the latest values of the request features from the Feast serving instance provided.
@@ -110,6 +132,11 @@ func (fc *GrpcClient) GetOnlineFeatures(ctx context.Context, req *OnlineFeatures
 	if err != nil {
 		return nil, err
 	}
+	if fc.authorizer != nil {
+		if err := fc.authorizer(ctx, req); err != nil {
+			return nil, err
+		}
+	}
 	resp, err := fc.cli.GetOnlineFeatures(ctx, featuresRequest)
 
 	// collect unqiue entity refs from entity rows
@@ -2,6 +2,7 @@ package feast
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/feast-dev/feast/sdk/go/mocks"
@@ -89,3 +90,26 @@ func TestGetOnlineFeatures(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOnlineFeaturesAuthorizer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cli := mock_serving.NewMockServingServiceClient(ctrl)
+	// Requests rejected by the authorizer should never be sent to serving.
+	cli.EXPECT().GetOnlineFeatures(gomock.Any(), gomock.Any()).Times(0)
+
+	denied := fmt.Errorf("not allowed to read driver:rating")
+	client := &GrpcClient{
+		cli: cli,
+		authorizer: func(ctx context.Context, req *OnlineFeaturesRequest) error {
+			return denied
+		},
+	}
+	_, err := client.GetOnlineFeatures(context.Background(), &OnlineFeaturesRequest{
+		Features: []string{"driver:rating"},
+		Entities: []Row{{"driver_id": Int64Val(1)}},
+	})
+	if err != denied {
+		t.Errorf("error = %v, expected err = %v", err, denied)
+	}
+}
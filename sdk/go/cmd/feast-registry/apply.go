@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/registry"
+	"github.com/spf13/cobra"
+)
+
+func newApplyCommand() *cobra.Command {
+	var project string
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "apply SPECS REGISTRY",
+		Short: "Apply the YAML specs of the SPECS directory to the REGISTRY",
+		Long: `Apply the YAML specs of the SPECS directory to the REGISTRY.
+
+Specs define entities, feature tables and feature views, see feast.LoadSpecs. Paths listed in the
+.feastignore file of the SPECS directory are skipped.
+The registry is given as a path, file://, http(s):// or s3:// location and is created if it does not exist.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if project == "" {
+				return fmt.Errorf("--project is required")
+			}
+			specs, err := feast.LoadSpecs(args[0])
+			if err != nil {
+				return err
+			}
+			store, err := registry.NewRegistryStore(args[1])
+			if err != nil {
+				return err
+			}
+			plan, err := registry.ApplySpecs(context.Background(), store, project, specs, dryRun)
+			if err != nil {
+				return err
+			}
+			return plan.WriteText(cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project the specs are applied to")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "show the changes without writing the registry")
+	return cmd
+}
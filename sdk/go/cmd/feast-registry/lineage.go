@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/feast-dev/feast/sdk/go/lineage"
+	"github.com/spf13/cobra"
+)
+
+func newLineageCommand() *cobra.Command {
+	var output string
+	var node string
+	cmd := &cobra.Command{
+		Use:   "lineage REGISTRY",
+		Short: "Export the lineage graph of the REGISTRY",
+		Long: `Export the lineage graph of the REGISTRY, relating feature services to the feature views,
+data sources and entities they depend on.
+
+The registry is given as a path, file://, http(s):// or s3:// location.
+Use --node to only export the dependencies and consumers of a single node, given by its id,
+ie. "feature_service:my_project/my_service" or "data_source:my_project/s3://bucket/driver_stats.parquet".`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			registryProto, err := loadRegistry(context.Background(), args[0], false)
+			if err != nil {
+				return err
+			}
+			graph := lineage.Build(registryProto)
+			if node != "" {
+				if _, ok := graph.Node(node); !ok {
+					return fmt.Errorf("node %s does not exist", node)
+				}
+				graph = graph.Subgraph(node)
+			}
+
+			switch output {
+			case "dot":
+				return graph.WriteDOT(cmd.OutOrStdout())
+			case "mermaid":
+				return graph.WriteMermaid(cmd.OutOrStdout())
+			case "json":
+				return graph.WriteJSON(cmd.OutOrStdout())
+			default:
+				return fmt.Errorf("unsupported output format: %s", output)
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "dot", "output format, one of dot, mermaid or json")
+	cmd.Flags().StringVar(&node, "node", "", "id of the node to export the lineage of")
+	return cmd
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/feast-dev/feast/sdk/go/registry"
+	"github.com/spf13/cobra"
+)
+
+// Exit code of the lint command when findings of at least the --fail-on severity are found.
+const exitLintFindings = 3
+
+func newLintCommand() *cobra.Command {
+	var output string
+	var failOn string
+	var disabled []string
+	cmd := &cobra.Command{
+		Use:   "lint REGISTRY",
+		Short: "Check the REGISTRY against the built-in lint rules",
+		Long: `Check the REGISTRY against the built-in lint rules.
+
+The registry is given as a path, file://, http(s):// or s3:// location.
+Rules can be suppressed for an object by listing them in its "` + registry.LintSuppressTag + `" tag.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "text" && output != "json" {
+				return fmt.Errorf("unsupported output format: %s", output)
+			}
+			failOnSeverity, err := registry.ParseSeverity(failOn)
+			if err != nil {
+				return err
+			}
+			registryProto, err := loadRegistry(context.Background(), args[0], false)
+			if err != nil {
+				return err
+			}
+
+			linter := registry.NewLinter(registry.BuiltinLintRules()...)
+			for _, rule := range disabled {
+				linter.Disable(rule)
+			}
+			report := linter.Lint(registryProto)
+			if output == "json" {
+				err = report.WriteJSON(cmd.OutOrStdout())
+			} else {
+				err = report.WriteText(cmd.OutOrStdout())
+			}
+			if err != nil {
+				return err
+			}
+			if report.HasFindings(failOnSeverity) {
+				return &exitError{code: exitLintFindings}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "output format, one of text or json")
+	cmd.Flags().StringVar(&failOn, "fail-on", "error",
+		fmt.Sprintf("exit with status %d if findings of at least this severity are found, one of info, warning or error", exitLintFindings))
+	cmd.Flags().StringSliceVar(&disabled, "disable", nil, "names of rules to disable")
+	return cmd
+}
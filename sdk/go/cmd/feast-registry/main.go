@@ -0,0 +1,38 @@
+// Command feast-registry inspects and updates Feast registries.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// exitError makes the command exit with the given status without reporting an error, ie. when a plan contains
+// destructive changes.
+type exitError struct {
+	code int
+}
+
+func (e *exitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:           "feast-registry",
+		Short:         "Inspect and update Feast registries",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	rootCmd.AddCommand(newPlanCommand(), newApplyCommand(), newLintCommand(), newLineageCommand(), newMaterializationCommand(), newServeCommand())
+	if err := rootCmd.Execute(); err != nil {
+		var exitErr *exitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.code)
+		}
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
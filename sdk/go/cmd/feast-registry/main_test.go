@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestMaterializationCommandExitCode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "feast-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "registry.db")
+	fv := feast.NewFeatureView("driver_stats", []string{"driver"},
+		feast.FileSource{Path: "driver_stats.parquet", EventTimestampColumn: "event_timestamp"},
+		feast.Feature{Name: "rating", ValueType: types.ValueType_DOUBLE},
+	).WithTTL(time.Hour)
+	data, err := proto.Marshal(&core.Registry{FeatureViews: []*core.FeatureView{fv.Proto()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name     string
+		args     []string
+		wantCode int
+	}{
+		{name: "Report only", args: []string{path}},
+		{name: "Fail on stale feature views", args: []string{path, "--fail"}, wantCode: exitMaterializationGaps},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newMaterializationCommand()
+			cmd.SetArgs(tc.args)
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+			err := cmd.Execute()
+			var exitErr *exitError
+			switch {
+			case tc.wantCode == 0 && err != nil:
+				t.Fatalf("Expected no error, got %v", err)
+			case tc.wantCode != 0 && !errors.As(err, &exitErr):
+				t.Fatalf("Expected exit status %d, got %v", tc.wantCode, err)
+			case tc.wantCode != 0 && exitErr.code != tc.wantCode:
+				t.Errorf("Expected exit status %d, got %d", tc.wantCode, exitErr.code)
+			}
+		})
+	}
+}
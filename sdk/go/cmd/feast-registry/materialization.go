@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/spf13/cobra"
+)
+
+// Exit code of the materialization command when gaps or stale feature views are found and --fail is set.
+const exitMaterializationGaps = 4
+
+func newMaterializationCommand() *cobra.Command {
+	var project string
+	var window time.Duration
+	var fail bool
+	cmd := &cobra.Command{
+		Use:   "materialization REGISTRY",
+		Short: "Report materialization gaps and stale feature views of the REGISTRY",
+		Long: `Report the time ranges online feature views of the REGISTRY have not been materialized for,
+and the feature views whose online values have all expired.
+
+The registry is given as a path, file://, http(s):// or s3:// location.
+Gaps are reported for the --window preceding now, or for the TTL of each feature view if unset.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			registryProto, err := loadRegistry(context.Background(), args[0], false)
+			if err != nil {
+				return err
+			}
+			end := time.Now()
+			var start time.Time
+			if window > 0 {
+				start = end.Add(-window)
+			}
+			statuses := feast.AnalyzeRegistryMaterialization(registryProto, project, start, end)
+			if err := feast.WriteMaterializationReport(cmd.OutOrStdout(), statuses); err != nil {
+				return err
+			}
+			for _, status := range statuses {
+				if fail && (status.Stale || status.HasGaps()) {
+					return &exitError{code: exitMaterializationGaps}
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project to report on, all projects if unset")
+	cmd.Flags().DurationVar(&window, "window", 0, "window preceding now to report gaps for, ie. 168h")
+	cmd.Flags().BoolVar(&fail, "fail", false, fmt.Sprintf("exit with status %d if gaps or stale feature views are found", exitMaterializationGaps))
+	return cmd
+}
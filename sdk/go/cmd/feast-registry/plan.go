@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/registry"
+	"github.com/spf13/cobra"
+)
+
+// Exit code of the plan command when the plan contains destructive changes and --fail-on-destructive is set.
+const exitDestructive = 2
+
+func newPlanCommand() *cobra.Command {
+	var output string
+	var failOnDestructive bool
+	cmd := &cobra.Command{
+		Use:   "plan CURRENT DESIRED",
+		Short: "Show the changes turning the CURRENT registry into the DESIRED registry",
+		Long: `Show the changes turning the CURRENT registry into the DESIRED registry.
+
+Registries are given as paths, file://, http(s):// or s3:// locations.
+A CURRENT registry that does not exist yet is treated as an empty registry.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "text" && output != "json" {
+				return fmt.Errorf("unsupported output format: %s", output)
+			}
+			ctx := context.Background()
+			current, err := loadRegistry(ctx, args[0], true)
+			if err != nil {
+				return err
+			}
+			desired, err := loadRegistry(ctx, args[1], false)
+			if err != nil {
+				return err
+			}
+
+			plan := registry.ComputePlan(current, desired)
+			if output == "json" {
+				err = plan.WriteJSON(cmd.OutOrStdout())
+			} else {
+				err = plan.WriteText(cmd.OutOrStdout())
+			}
+			if err != nil {
+				return err
+			}
+			if failOnDestructive && plan.Destructive() {
+				return &exitError{code: exitDestructive}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "output format, one of text or json")
+	cmd.Flags().BoolVar(&failOnDestructive, "fail-on-destructive", false,
+		fmt.Sprintf("exit with status %d if the plan contains destructive changes", exitDestructive))
+	return cmd
+}
+
+// Loads the registry at the given location, returning an empty registry if it
+// does not exist and allowMissing is set.
+func loadRegistry(ctx context.Context, location string, allowMissing bool) (*core.Registry, error) {
+	store, err := registry.NewRegistryStore(location)
+	if err != nil {
+		return nil, err
+	}
+	registryProto, err := store.Get(ctx)
+	if errors.Is(err, registry.ErrRegistryNotFound) && allowMissing {
+		return &core.Registry{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load registry %s: %v", location, err)
+	}
+	return registryProto, nil
+}
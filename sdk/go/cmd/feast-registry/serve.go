@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/registry"
+	"github.com/feast-dev/feast/sdk/go/registry/rest"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Timeouts of the HTTP server, protecting it from slow or idle clients.
+	readTimeout  = 10 * time.Second
+	writeTimeout = 30 * time.Second
+	idleTimeout  = 2 * time.Minute
+)
+
+func newServeCommand() *cobra.Command {
+	var addr string
+	var refreshInterval time.Duration
+	cmd := &cobra.Command{
+		Use:   "serve REGISTRY",
+		Short: "Serve a read-only REST API for browsing the REGISTRY",
+		Long: `Serve a read-only REST API for browsing the entities, feature views, feature services and
+data sources of the REGISTRY. The OpenAPI document of the API is served at /openapi.json.
+
+The registry is given as a path, file://, http(s):// or s3:// location and is reloaded
+every --refresh-interval.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := registry.NewRegistryStore(args[0])
+			if err != nil {
+				return err
+			}
+			reg, err := registry.NewRegistryFromStore(store, refreshInterval)
+			if err != nil {
+				return fmt.Errorf("failed to load registry %s: %v", args[0], err)
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Serving registry %s on %s\n", args[0], addr)
+			server := &http.Server{
+				Addr:         addr,
+				Handler:      rest.NewServer(reg),
+				ReadTimeout:  readTimeout,
+				WriteTimeout: writeTimeout,
+				IdleTimeout:  idleTimeout,
+			}
+			return server.ListenAndServe()
+		},
+	}
+	cmd.Flags().StringVar(&addr, "addr", ":8080", "address to listen on")
+	cmd.Flags().DurationVar(&refreshInterval, "refresh-interval", time.Minute, "interval after which the registry is reloaded")
+	return cmd
+}
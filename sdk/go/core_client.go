@@ -0,0 +1,289 @@
+package feast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	// ErrNotFound is returned when the requested object does not exist in Feast Core.
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists is returned when creating an object that already exists in Feast Core.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrInvalidArgument is returned when Feast Core rejects an object or request as invalid.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrPermissionDenied is returned when the caller is not authenticated or not allowed to perform a request.
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrUnavailable is returned when Feast Core could not be reached or did not respond in time.
+	ErrUnavailable = errors.New("unavailable")
+)
+
+// CoreError is an error returned by Feast Core. Use errors.Is to check it against ErrNotFound, ErrAlreadyExists,
+// ErrInvalidArgument, ErrPermissionDenied and ErrUnavailable.
+type CoreError struct {
+	// Op is the CoreService method that failed, ie. "ApplyFeatureTable".
+	Op      string
+	Code    codes.Code
+	Message string
+}
+
+func (e *CoreError) Error() string {
+	return fmt.Sprintf("feast core %s failed: %s: %s", e.Op, e.Code, e.Message)
+}
+
+// Unwrap returns the sentinel error matching the code of the error, if any.
+func (e *CoreError) Unwrap() error {
+	switch e.Code {
+	case codes.NotFound:
+		return ErrNotFound
+	case codes.AlreadyExists:
+		return ErrAlreadyExists
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return ErrInvalidArgument
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return ErrPermissionDenied
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return ErrUnavailable
+	default:
+		return nil
+	}
+}
+
+// Converts errors returned by the core service into *CoreError.
+func coreError(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return &CoreError{Op: op, Code: st.Code(), Message: st.Message()}
+}
+
+// CoreClient manages entities, feature tables and projects registered in Feast Core.
+type CoreClient struct {
+	cli  core.CoreServiceClient
+	conn *grpc.ClientConn
+}
+
+// NewCoreClient constructs a client for the Feast Core instance at the given host:port.
+// The security config is applied as for serving clients, see NewSecureGrpcClient.
+// opts - grpc.DialOptions which should be used with this connection
+func NewCoreClient(host string, port int, security SecurityConfig, opts ...grpc.DialOption) (*CoreClient, error) {
+	conn, err := dial(host, port, security, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &CoreClient{cli: core.NewCoreServiceClient(conn), conn: conn}, nil
+}
+
+// Close the grpc connection.
+func (c *CoreClient) Close() error {
+	return c.conn.Close()
+}
+
+// GetEntity returns the entity with the given name in the given project.
+func (c *CoreClient) GetEntity(ctx context.Context, project string, name string) (*Entity, error) {
+	resp, err := c.cli.GetEntity(ctx, &core.GetEntityRequest{Project: project, Name: name})
+	if err != nil {
+		return nil, coreError("GetEntity", err)
+	}
+	return NewEntityFromProto(resp.GetEntity()), nil
+}
+
+// ApplyEntity creates the entity in the given project, or updates it if it already exists.
+// Changing the value type of an existing entity is rejected by Feast Core.
+func (c *CoreClient) ApplyEntity(ctx context.Context, project string, entity *Entity) (*Entity, error) {
+	resp, err := c.cli.ApplyEntity(ctx, &core.ApplyEntityRequest{Project: project, Spec: entity.Proto().GetSpec()})
+	if err != nil {
+		return nil, coreError("ApplyEntity", err)
+	}
+	return NewEntityFromProto(resp.GetEntity()), nil
+}
+
+// ListFilter selects the objects of a project to list.
+type ListFilter struct {
+	// Project to list objects of. Feast Core uses its default project if empty.
+	Project string
+	// Labels the listed objects must have.
+	Labels map[string]string
+}
+
+// ListEntities returns the entities matching the given filter.
+func (c *CoreClient) ListEntities(ctx context.Context, filter ListFilter) ([]*Entity, error) {
+	resp, err := c.cli.ListEntities(ctx, &core.ListEntitiesRequest{
+		Filter: &core.ListEntitiesRequest_Filter{Project: filter.Project, Labels: filter.Labels},
+	})
+	if err != nil {
+		return nil, coreError("ListEntities", err)
+	}
+	entities := make([]*Entity, len(resp.GetEntities()))
+	for i, entity := range resp.GetEntities() {
+		entities[i] = NewEntityFromProto(entity)
+	}
+	return entities, nil
+}
+
+// GetFeatureTable returns the feature table with the given name in the given project.
+func (c *CoreClient) GetFeatureTable(ctx context.Context, project string, name string) (*core.FeatureTable, error) {
+	resp, err := c.cli.GetFeatureTable(ctx, &core.GetFeatureTableRequest{Project: project, Name: name})
+	if err != nil {
+		return nil, coreError("GetFeatureTable", err)
+	}
+	return resp.GetTable(), nil
+}
+
+// ApplyFeatureTable creates the feature table in the given project, or updates it if it already exists.
+// Changing the entities or the names and types of features of an existing feature table is rejected by Feast Core.
+func (c *CoreClient) ApplyFeatureTable(ctx context.Context, project string, spec *core.FeatureTableSpec) (*core.FeatureTable, error) {
+	resp, err := c.cli.ApplyFeatureTable(ctx, &core.ApplyFeatureTableRequest{Project: project, TableSpec: spec})
+	if err != nil {
+		return nil, coreError("ApplyFeatureTable", err)
+	}
+	return resp.GetTable(), nil
+}
+
+// ListFeatureTables returns the feature tables matching the given filter.
+func (c *CoreClient) ListFeatureTables(ctx context.Context, filter ListFilter) ([]*core.FeatureTable, error) {
+	resp, err := c.cli.ListFeatureTables(ctx, &core.ListFeatureTablesRequest{
+		Filter: &core.ListFeatureTablesRequest_Filter{Project: filter.Project, Labels: filter.Labels},
+	})
+	if err != nil {
+		return nil, coreError("ListFeatureTables", err)
+	}
+	return resp.GetTables(), nil
+}
+
+// DeleteFeatureTable deletes the feature table with the given name from the given project.
+func (c *CoreClient) DeleteFeatureTable(ctx context.Context, project string, name string) error {
+	_, err := c.cli.DeleteFeatureTable(ctx, &core.DeleteFeatureTableRequest{Project: project, Name: name})
+	return coreError("DeleteFeatureTable", err)
+}
+
+// CreateProject creates a project with the given name.
+func (c *CoreClient) CreateProject(ctx context.Context, name string) error {
+	_, err := c.cli.CreateProject(ctx, &core.CreateProjectRequest{Name: name})
+	return coreError("CreateProject", err)
+}
+
+// ArchiveProject archives the project with the given name. Archived projects can not be restored through Feast Core.
+func (c *CoreClient) ArchiveProject(ctx context.Context, name string) error {
+	_, err := c.cli.ArchiveProject(ctx, &core.ArchiveProjectRequest{Name: name})
+	return coreError("ArchiveProject", err)
+}
+
+// ListProjects returns the names of the active projects.
+func (c *CoreClient) ListProjects(ctx context.Context) ([]string, error) {
+	resp, err := c.cli.ListProjects(ctx, &core.ListProjectsRequest{})
+	if err != nil {
+		return nil, coreError("ListProjects", err)
+	}
+	return resp.GetProjects(), nil
+}
+
+// ApplyResult lists the objects changed and left unchanged by Apply, as "entity <name>" or "feature table <name>".
+type ApplyResult struct {
+	Applied   []string
+	Unchanged []string
+}
+
+// Apply applies the given entities and feature tables to the given project, skipping objects that have not changed.
+// Entities are unchanged if their spec matches the registered one. Feature tables are unchanged if their spec
+// matches the registered one, once normalized as Feast Core does (see featureTableSpecEqual).
+// Entities are applied before feature tables, which may reference them. Apply stops at the first error, returning
+// the objects applied so far.
+func (c *CoreClient) Apply(ctx context.Context, project string, entities []*Entity, featureTables []*core.FeatureTableSpec) (*ApplyResult, error) {
+	result := &ApplyResult{}
+	for _, entity := range entities {
+		name := "entity " + entity.Name()
+		existing, err := c.GetEntity(ctx, project, entity.Name())
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return result, err
+		}
+		if err == nil && entitySpecEqual(existing.Proto().GetSpec(), entity.Proto().GetSpec()) {
+			result.Unchanged = append(result.Unchanged, name)
+			continue
+		}
+		if _, err := c.ApplyEntity(ctx, project, entity); err != nil {
+			return result, err
+		}
+		result.Applied = append(result.Applied, name)
+	}
+
+	for _, spec := range featureTables {
+		name := "feature table " + spec.GetName()
+		existing, err := c.GetFeatureTable(ctx, project, spec.GetName())
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return result, err
+		}
+		if err == nil && featureTableSpecEqual(existing.GetSpec(), spec) {
+			result.Unchanged = append(result.Unchanged, name)
+			continue
+		}
+		if _, err := c.ApplyFeatureTable(ctx, project, spec); err != nil {
+			return result, err
+		}
+		result.Applied = append(result.Applied, name)
+	}
+	return result, nil
+}
+
+// Reports whether the entity specs are equal, ignoring their project which is set by Feast Core.
+func entitySpecEqual(a *core.EntitySpecV2, b *core.EntitySpecV2) bool {
+	a, b = proto.Clone(a).(*core.EntitySpecV2), proto.Clone(b).(*core.EntitySpecV2)
+	a.Project, b.Project = "", ""
+	return proto.Equal(a, b)
+}
+
+func labelsEqual(a map[string]string, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if bValue, ok := b[key]; !ok || bValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Reports whether the feature table specs are equal once normalized as Feast Core does: ignoring their project,
+// the order of their entities and features and the sub-second part of their max age.
+func featureTableSpecEqual(a *core.FeatureTableSpec, b *core.FeatureTableSpec) bool {
+	return a.GetName() == b.GetName() && labelsEqual(a.GetLabels(), b.GetLabels()) &&
+		proto.Equal(normalizeFeatureTableSpec(a), normalizeFeatureTableSpec(b))
+}
+
+// Returns the parts of a feature table spec Feast Core hashes: its sorted entities, features sorted by name,
+// sources and max age in seconds.
+func normalizeFeatureTableSpec(spec *core.FeatureTableSpec) *core.FeatureTableSpec {
+	entities := append([]string{}, spec.GetEntities()...)
+	sort.Strings(entities)
+	features := append([]*core.FeatureSpecV2{}, spec.GetFeatures()...)
+	sort.SliceStable(features, func(i, j int) bool { return features[i].GetName() < features[j].GetName() })
+	streamSource := spec.GetStreamSource()
+	if streamSource == nil {
+		streamSource = &core.DataSource{}
+	}
+	batchSource := spec.GetBatchSource()
+	if batchSource == nil {
+		batchSource = &core.DataSource{}
+	}
+	return &core.FeatureTableSpec{
+		Entities:     entities,
+		Features:     features,
+		BatchSource:  batchSource,
+		StreamSource: streamSource,
+		MaxAge:       &durationpb.Duration{Seconds: spec.GetMaxAge().GetSeconds()},
+	}
+}
@@ -0,0 +1,186 @@
+package feast
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// fakeCoreService is an in memory CoreServiceClient storing entities and feature tables of a single project,
+// hashing feature tables like Feast Core.
+type fakeCoreService struct {
+	core.CoreServiceClient
+	entities      map[string]*core.Entity
+	featureTables map[string]*core.FeatureTable
+	applied       []string
+}
+
+func newFakeCoreService() *fakeCoreService {
+	return &fakeCoreService{entities: make(map[string]*core.Entity), featureTables: make(map[string]*core.FeatureTable)}
+}
+
+func (s *fakeCoreService) GetEntity(ctx context.Context, in *core.GetEntityRequest, opts ...grpc.CallOption) (*core.GetEntityResponse, error) {
+	entity, ok := s.entities[in.Name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "entity %s does not exist", in.Name)
+	}
+	return &core.GetEntityResponse{Entity: entity}, nil
+}
+
+func (s *fakeCoreService) ApplyEntity(ctx context.Context, in *core.ApplyEntityRequest, opts ...grpc.CallOption) (*core.ApplyEntityResponse, error) {
+	spec := proto.Clone(in.Spec).(*core.EntitySpecV2)
+	spec.Project = in.Project
+	s.entities[spec.Name] = &core.Entity{Spec: spec, Meta: &core.EntityMeta{}}
+	s.applied = append(s.applied, "entity "+spec.Name)
+	return &core.ApplyEntityResponse{Entity: s.entities[spec.Name]}, nil
+}
+
+func (s *fakeCoreService) GetFeatureTable(ctx context.Context, in *core.GetFeatureTableRequest, opts ...grpc.CallOption) (*core.GetFeatureTableResponse, error) {
+	featureTable, ok := s.featureTables[in.Name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "feature table %s does not exist", in.Name)
+	}
+	return &core.GetFeatureTableResponse{Table: featureTable}, nil
+}
+
+func (s *fakeCoreService) ApplyFeatureTable(ctx context.Context, in *core.ApplyFeatureTableRequest, opts ...grpc.CallOption) (*core.ApplyFeatureTableResponse, error) {
+	if existing, ok := s.featureTables[in.TableSpec.Name]; ok && existing.Spec.Entities[0] != in.TableSpec.Entities[0] {
+		return nil, status.Errorf(codes.InvalidArgument, "entities of feature table %s can not be changed", in.TableSpec.Name)
+	}
+	spec := proto.Clone(in.TableSpec).(*core.FeatureTableSpec)
+	spec.Project = in.Project
+	// Feast Core registers the features of tables sorted by name, along with a hash.
+	sort.Slice(spec.Features, func(i, j int) bool { return spec.Features[i].Name < spec.Features[j].Name })
+	s.featureTables[spec.Name] = &core.FeatureTable{Spec: spec, Meta: &core.FeatureTableMeta{Hash: "2f0c5b1e"}}
+	s.applied = append(s.applied, "feature table "+spec.Name)
+	return &core.ApplyFeatureTableResponse{Table: s.featureTables[spec.Name]}, nil
+}
+
+func testFeatureTableSpec() *core.FeatureTableSpec {
+	return &core.FeatureTableSpec{
+		Name:     "driver_stats",
+		Entities: []string{"driver"},
+		Features: []*core.FeatureSpecV2{
+			{Name: "trips", ValueType: types.ValueType_INT64},
+			{Name: "rating", ValueType: types.ValueType_DOUBLE},
+		},
+		MaxAge:      durationpb.New(time.Hour),
+		BatchSource: FileSource{Path: "driver_stats.parquet", EventTimestampColumn: "event_timestamp"}.Proto(),
+	}
+}
+
+func TestCoreClientApply(t *testing.T) {
+	service := newFakeCoreService()
+	client := &CoreClient{cli: service}
+	ctx := context.Background()
+	driver := NewEntity("driver", types.ValueType_INT64).WithJoinKey("driver_id")
+
+	tt := []struct {
+		name          string
+		entities      []*Entity
+		featureTables []*core.FeatureTableSpec
+		want          *ApplyResult
+	}{
+		{
+			name:          "Create",
+			entities:      []*Entity{driver},
+			featureTables: []*core.FeatureTableSpec{testFeatureTableSpec()},
+			want:          &ApplyResult{Applied: []string{"entity driver", "feature table driver_stats"}},
+		},
+		{
+			name:          "Unchanged",
+			entities:      []*Entity{driver},
+			featureTables: []*core.FeatureTableSpec{testFeatureTableSpec()},
+			want:          &ApplyResult{Unchanged: []string{"entity driver", "feature table driver_stats"}},
+		},
+		{
+			name:     "Reordered features are unchanged",
+			entities: []*Entity{driver},
+			featureTables: func() []*core.FeatureTableSpec {
+				spec := testFeatureTableSpec()
+				spec.Features[0], spec.Features[1] = spec.Features[1], spec.Features[0]
+				return []*core.FeatureTableSpec{spec}
+			}(),
+			want: &ApplyResult{Unchanged: []string{"entity driver", "feature table driver_stats"}},
+		},
+		{
+			name:     "Sub-second max age changes are unchanged",
+			entities: []*Entity{driver},
+			featureTables: func() []*core.FeatureTableSpec {
+				spec := testFeatureTableSpec()
+				spec.MaxAge = durationpb.New(time.Hour + time.Millisecond)
+				return []*core.FeatureTableSpec{spec}
+			}(),
+			want: &ApplyResult{Unchanged: []string{"entity driver", "feature table driver_stats"}},
+		},
+		{
+			name:     "Updated max age",
+			entities: []*Entity{driver},
+			featureTables: func() []*core.FeatureTableSpec {
+				spec := testFeatureTableSpec()
+				spec.MaxAge = durationpb.New(2 * time.Hour)
+				return []*core.FeatureTableSpec{spec}
+			}(),
+			want: &ApplyResult{Applied: []string{"feature table driver_stats"}, Unchanged: []string{"entity driver"}},
+		},
+		{
+			name:     "Updated",
+			entities: []*Entity{NewEntity("driver", types.ValueType_INT64).WithJoinKey("driver_id").WithDescription("Drivers")},
+			featureTables: func() []*core.FeatureTableSpec {
+				spec := testFeatureTableSpec()
+				spec.MaxAge = durationpb.New(2 * time.Hour)
+				spec.Labels = map[string]string{"team": "drivers"}
+				return []*core.FeatureTableSpec{spec}
+			}(),
+			want: &ApplyResult{Applied: []string{"entity driver", "feature table driver_stats"}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := client.Apply(ctx, "driver_project", tc.entities, tc.featureTables)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected apply result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCoreClientErrors(t *testing.T) {
+	service := newFakeCoreService()
+	client := &CoreClient{cli: service}
+	ctx := context.Background()
+
+	_, err := client.GetFeatureTable(ctx, "driver_project", "driver_stats")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if _, err := client.ApplyFeatureTable(ctx, "driver_project", testFeatureTableSpec()); err != nil {
+		t.Fatal(err)
+	}
+
+	spec := testFeatureTableSpec()
+	spec.Entities = []string{"vehicle"}
+	_, err = client.ApplyFeatureTable(ctx, "driver_project", spec)
+	var coreErr *CoreError
+	if !errors.As(err, &coreErr) || !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("Expected *CoreError wrapping ErrInvalidArgument, got %v", err)
+	}
+	want := "feast core ApplyFeatureTable failed: InvalidArgument: entities of feature table driver_stats can not be changed"
+	if coreErr.Error() != want {
+		t.Errorf("Expected error %q, got %q", want, coreErr.Error())
+	}
+}
@@ -0,0 +1,89 @@
+package feast
+
+import (
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+// Python class of file sources, used by the Python SDK to deserialize data sources.
+const fileSourceClassType = "feast.infra.offline_stores.file_source.FileSource"
+
+// DataSource is a source of feature data used to define feature views.
+type DataSource interface {
+	// Proto returns the DataSource proto describing the source.
+	Proto() *core.DataSource
+}
+
+// FileSource is a batch source of feature data stored in parquet files.
+type FileSource struct {
+	// Path or URL (ie. s3://bucket/driver_stats.parquet) of the parquet file or directory.
+	Path string
+	// Column holding the event timestamps of feature rows.
+	EventTimestampColumn string
+	// Column holding the creation timestamps of feature rows, used to deduplicate rows with equal event timestamps.
+	CreatedTimestampColumn string
+	// Column used to partition the data by date.
+	DatePartitionColumn string
+	// Mapping from column names in the source to feature and entity names.
+	FieldMapping map[string]string
+	// Custom S3 endpoint used to read s3:// paths.
+	S3EndpointOverride string
+}
+
+// Proto returns the DataSource proto describing the file source.
+func (s FileSource) Proto() *core.DataSource {
+	return &core.DataSource{
+		Type:                   core.DataSource_BATCH_FILE,
+		FieldMapping:           s.FieldMapping,
+		EventTimestampColumn:   s.EventTimestampColumn,
+		CreatedTimestampColumn: s.CreatedTimestampColumn,
+		DatePartitionColumn:    s.DatePartitionColumn,
+		DataSourceClassType:    fileSourceClassType,
+		Options: &core.DataSource_FileOptions_{
+			FileOptions: &core.DataSource_FileOptions{
+				FileFormat:         &core.FileFormat{Format: &core.FileFormat_ParquetFormat_{ParquetFormat: &core.FileFormat_ParquetFormat{}}},
+				FileUrl:            s.Path,
+				S3EndpointOverride: s.S3EndpointOverride,
+			},
+		},
+	}
+}
+
+// DataSourceName returns a name identifying the data read by the given data source, ie. the path of
+// a file source, the table or query of a warehouse source or the topic of a stream source.
+// Returns an empty string if the source has no options identifying its data.
+func DataSourceName(source *core.DataSource) string {
+	tableOrQuery := func(table string, query string) string {
+		if table != "" {
+			return table
+		}
+		return query
+	}
+	switch options := source.GetOptions().(type) {
+	case *core.DataSource_FileOptions_:
+		return options.FileOptions.GetFileUrl()
+	case *core.DataSource_BigqueryOptions:
+		return tableOrQuery(options.BigqueryOptions.GetTableRef(), options.BigqueryOptions.GetQuery())
+	case *core.DataSource_RedshiftOptions_:
+		table := options.RedshiftOptions.GetTable()
+		if table != "" && options.RedshiftOptions.GetSchema() != "" {
+			table = options.RedshiftOptions.GetSchema() + "." + table
+		}
+		return tableOrQuery(table, options.RedshiftOptions.GetQuery())
+	case *core.DataSource_SnowflakeOptions_:
+		table := options.SnowflakeOptions.GetTable()
+		if table != "" && options.SnowflakeOptions.GetSchema() != "" {
+			table = options.SnowflakeOptions.GetSchema() + "." + table
+		}
+		if table != "" && options.SnowflakeOptions.GetDatabase() != "" {
+			table = options.SnowflakeOptions.GetDatabase() + "." + table
+		}
+		return tableOrQuery(table, options.SnowflakeOptions.GetQuery())
+	case *core.DataSource_KafkaOptions_:
+		return options.KafkaOptions.GetTopic()
+	case *core.DataSource_KinesisOptions_:
+		return options.KinesisOptions.GetStreamName()
+	case *core.DataSource_RequestDataOptions_:
+		return options.RequestDataOptions.GetName()
+	}
+	return ""
+}
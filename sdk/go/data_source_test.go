@@ -0,0 +1,55 @@
+package feast
+
+import (
+	"testing"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+func TestDataSourceName(t *testing.T) {
+	tt := []struct {
+		name   string
+		source *core.DataSource
+		want   string
+	}{
+		{
+			name:   "File source",
+			source: FileSource{Path: "s3://bucket/driver_stats.parquet"}.Proto(),
+			want:   "s3://bucket/driver_stats.parquet",
+		},
+		{
+			name: "BigQuery query",
+			source: &core.DataSource{Options: &core.DataSource_BigqueryOptions{
+				BigqueryOptions: &core.DataSource_BigQueryOptions{Query: "SELECT * FROM drivers"},
+			}},
+			want: "SELECT * FROM drivers",
+		},
+		{
+			name: "Snowflake table",
+			source: &core.DataSource{Options: &core.DataSource_SnowflakeOptions_{
+				SnowflakeOptions: &core.DataSource_SnowflakeOptions{Database: "FEAST", Schema: "PUBLIC", Table: "DRIVERS"},
+			}},
+			want: "FEAST.PUBLIC.DRIVERS",
+		},
+		{
+			name: "Kafka topic",
+			source: &core.DataSource{Options: &core.DataSource_KafkaOptions_{
+				KafkaOptions: &core.DataSource_KafkaOptions{BootstrapServers: "kafka:9092", Topic: "trips"},
+			}},
+			want: "trips",
+		},
+		{
+			name:   "Unset options",
+			source: &core.DataSource{},
+			want:   "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := DataSourceName(tc.source); got != tc.want {
+				t.Errorf("Expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package feast
+
+import (
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+)
+
+// Entity is a wrapper around core.Entity.
+type Entity struct {
+	proto *core.Entity
+}
+
+// NewEntityFromProto wraps the given Entity proto.
+func NewEntityFromProto(proto *core.Entity) *Entity {
+	return &Entity{proto: proto}
+}
+
+// NewEntity defines an entity with the given name and join key value type.
+// The entity's join key defaults to its name.
+func NewEntity(name string, valueType types.ValueType_Enum) *Entity {
+	return &Entity{proto: &core.Entity{Spec: &core.EntitySpecV2{
+		Name:      name,
+		ValueType: valueType,
+		JoinKey:   name,
+	}}}
+}
+
+// WithJoinKey sets the name of the column the entity maps to.
+func (e *Entity) WithJoinKey(joinKey string) *Entity {
+	e.proto.Spec.JoinKey = joinKey
+	return e
+}
+
+// WithDescription sets the description of the entity.
+func (e *Entity) WithDescription(description string) *Entity {
+	e.proto.Spec.Description = description
+	return e
+}
+
+// WithLabels sets the user defined metadata of the entity.
+func (e *Entity) WithLabels(labels map[string]string) *Entity {
+	e.proto.Spec.Labels = labels
+	return e
+}
+
+// Proto returns the underlying Entity proto.
+func (e *Entity) Proto() *core.Entity {
+	return e.proto
+}
+
+// Name returns the name of the entity.
+func (e *Entity) Name() string {
+	return e.proto.GetSpec().GetName()
+}
+
+// Project returns the name of the project the entity belongs to.
+func (e *Entity) Project() string {
+	return e.proto.GetSpec().GetProject()
+}
+
+// ValueType returns the value type of the entity's join key.
+func (e *Entity) ValueType() types.ValueType_Enum {
+	return e.proto.GetSpec().GetValueType()
+}
+
+// JoinKey returns the name of the column the entity maps to. Defaults to the entity's name if unspecified.
+func (e *Entity) JoinKey() string {
+	if joinKey := e.proto.GetSpec().GetJoinKey(); joinKey != "" {
+		return joinKey
+	}
+	return e.Name()
+}
+
+// Description returns the description of the entity.
+func (e *Entity) Description() string {
+	return e.proto.GetSpec().GetDescription()
+}
+
+// Labels returns the user defined metadata of the entity.
+func (e *Entity) Labels() map[string]string {
+	return e.proto.GetSpec().GetLabels()
+}
+
+// CreatedTimestamp returns the time the entity was created, zero if unknown.
+func (e *Entity) CreatedTimestamp() time.Time {
+	return asTime(e.proto.GetMeta().GetCreatedTimestamp())
+}
+
+// LastUpdatedTimestamp returns the time the entity was last updated, zero if unknown.
+func (e *Entity) LastUpdatedTimestamp() time.Time {
+	return asTime(e.proto.GetMeta().GetLastUpdatedTimestamp())
+}
@@ -0,0 +1,262 @@
+package feast
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Version of the registry schema written by the Python SDK.
+const registrySchemaVersion = "1"
+
+// Project names may only contain letters, digits and underscores.
+var validProjectName = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// ValidationError lists the problems found validating feature definitions.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid feature definitions: " + strings.Join(e.Problems, "; ")
+}
+
+// FeatureRepo is a set of feature definitions belonging to a single project,
+// which can be validated and written to a Registry proto.
+type FeatureRepo struct {
+	Project         string
+	Entities        []*Entity
+	FeatureViews    []*FeatureView
+	FeatureServices []*FeatureService
+}
+
+// Validate checks that the definitions are complete and only reference objects defined by the repo.
+// Returns a *ValidationError listing all problems found.
+func (repo *FeatureRepo) Validate() error {
+	return repo.validate(nil)
+}
+
+// BuildRegistry returns a Registry proto holding the definitions of the repo.
+// Returns a *ValidationError if the definitions are invalid.
+func (repo *FeatureRepo) BuildRegistry() (*core.Registry, error) {
+	return repo.MergeRegistry(nil)
+}
+
+// MergeRegistry returns a copy of the given Registry proto with the definitions of the repo added to it,
+// replacing objects of the same name in the repo's project. Other objects are kept as is, with the creation
+// time of replaced objects being preserved. Definitions may reference entities and feature views of the project
+// defined in the given registry. Returns a *ValidationError if the definitions are invalid.
+func (repo *FeatureRepo) MergeRegistry(registry *core.Registry) (*core.Registry, error) {
+	if err := repo.validate(registry); err != nil {
+		return nil, err
+	}
+
+	merged := &core.Registry{}
+	if registry != nil {
+		merged = proto.Clone(registry).(*core.Registry)
+	}
+	if merged.RegistrySchemaVersion == "" {
+		merged.RegistrySchemaVersion = registrySchemaVersion
+	}
+	now := timestamppb.New(time.Now())
+	merged.LastUpdated = now
+
+	for _, entity := range repo.Entities {
+		entityProto := proto.Clone(entity.Proto()).(*core.Entity)
+		entityProto.Spec.Project = repo.Project
+		entityProto.Meta = &core.EntityMeta{CreatedTimestamp: now, LastUpdatedTimestamp: now}
+		replaced := false
+		for i, existing := range merged.Entities {
+			if existing.GetSpec().GetProject() == repo.Project && existing.GetSpec().GetName() == entity.Name() {
+				entityProto.Meta.CreatedTimestamp = createdTimestamp(existing.GetMeta().GetCreatedTimestamp(), now)
+				merged.Entities[i], replaced = entityProto, true
+			}
+		}
+		if !replaced {
+			merged.Entities = append(merged.Entities, entityProto)
+		}
+	}
+	for _, featureView := range repo.FeatureViews {
+		featureViewProto := proto.Clone(featureView.Proto()).(*core.FeatureView)
+		featureViewProto.Spec.Project = repo.Project
+		featureViewProto.Meta = &core.FeatureViewMeta{CreatedTimestamp: now, LastUpdatedTimestamp: now}
+		replaced := false
+		for i, existing := range merged.FeatureViews {
+			if existing.GetSpec().GetProject() == repo.Project && existing.GetSpec().GetName() == featureView.Name() {
+				featureViewProto.Meta.CreatedTimestamp = createdTimestamp(existing.GetMeta().GetCreatedTimestamp(), now)
+				// Materialization state belongs to the stored feature view and must survive redefinition.
+				featureViewProto.Meta.MaterializationIntervals = existing.GetMeta().GetMaterializationIntervals()
+				merged.FeatureViews[i], replaced = featureViewProto, true
+			}
+		}
+		if !replaced {
+			merged.FeatureViews = append(merged.FeatureViews, featureViewProto)
+		}
+	}
+	for _, featureService := range repo.FeatureServices {
+		featureServiceProto := proto.Clone(featureService.Proto()).(*core.FeatureService)
+		featureServiceProto.Spec.Project = repo.Project
+		featureServiceProto.Meta = &core.FeatureServiceMeta{CreatedTimestamp: now, LastUpdatedTimestamp: now}
+		replaced := false
+		for i, existing := range merged.FeatureServices {
+			if existing.GetSpec().GetProject() == repo.Project && existing.GetSpec().GetName() == featureService.Name() {
+				featureServiceProto.Meta.CreatedTimestamp = createdTimestamp(existing.GetMeta().GetCreatedTimestamp(), now)
+				merged.FeatureServices[i], replaced = featureServiceProto, true
+			}
+		}
+		if !replaced {
+			merged.FeatureServices = append(merged.FeatureServices, featureServiceProto)
+		}
+	}
+	return merged, nil
+}
+
+// Returns the creation time of an existing object, or now if it's unknown.
+func createdTimestamp(existing *timestamppb.Timestamp, now *timestamppb.Timestamp) *timestamppb.Timestamp {
+	if existing == nil {
+		return now
+	}
+	return existing
+}
+
+// Validates the definitions of the repo, which may reference objects of the project in the given registry.
+func (repo *FeatureRepo) validate(registry *core.Registry) error {
+	var problems []string
+	addProblem := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+	if !validProjectName.MatchString(repo.Project) {
+		addProblem("invalid project name %q", repo.Project)
+	}
+
+	// Objects of the project defined in the registry, replaced by definitions of the repo.
+	entities := make(map[string]bool)
+	features := make(map[string]map[string]types.ValueType_Enum)
+	for _, entity := range registry.GetEntities() {
+		if entity.GetSpec().GetProject() == repo.Project {
+			entities[entity.GetSpec().GetName()] = true
+		}
+	}
+	addFeatures := func(name string, specs []*core.FeatureSpecV2) {
+		features[name] = make(map[string]types.ValueType_Enum)
+		for _, spec := range specs {
+			features[name][spec.GetName()] = spec.GetValueType()
+		}
+	}
+	for _, featureView := range registry.GetFeatureViews() {
+		if featureView.GetSpec().GetProject() == repo.Project {
+			addFeatures(featureView.GetSpec().GetName(), featureView.GetSpec().GetFeatures())
+		}
+	}
+	for _, odfv := range registry.GetOnDemandFeatureViews() {
+		if odfv.GetSpec().GetProject() == repo.Project {
+			addFeatures(odfv.GetSpec().GetName(), odfv.GetSpec().GetFeatures())
+		}
+	}
+
+	defined := make(map[string]bool)
+	for _, entity := range repo.Entities {
+		name := entity.Name()
+		switch {
+		case name == "":
+			addProblem("entity name must not be empty")
+		case defined["entity "+name]:
+			addProblem("entity %s is defined more than once", name)
+		}
+		if entity.ValueType() == types.ValueType_INVALID {
+			addProblem("entity %s must have a value type", name)
+		}
+		defined["entity "+name] = true
+		entities[name] = true
+	}
+
+	for _, featureView := range repo.FeatureViews {
+		name := featureView.Name()
+		switch {
+		case name == "":
+			addProblem("feature view name must not be empty")
+		case defined["feature view "+name]:
+			addProblem("feature view %s is defined more than once", name)
+		}
+		defined["feature view "+name] = true
+
+		for _, entity := range featureView.Entities() {
+			if !entities[entity] {
+				addProblem("feature view %s references undefined entity %s", name, entity)
+			}
+		}
+		if len(featureView.Features()) == 0 {
+			addProblem("feature view %s must define at least one feature", name)
+		}
+		seen := make(map[string]bool)
+		for _, feature := range featureView.Features() {
+			switch {
+			case feature.Name == "":
+				addProblem("feature view %s has a feature without a name", name)
+			case seen[feature.Name]:
+				addProblem("feature view %s defines feature %s more than once", name, feature.Name)
+			}
+			if feature.ValueType == types.ValueType_INVALID {
+				addProblem("feature %s:%s must have a value type", name, feature.Name)
+			}
+			seen[feature.Name] = true
+		}
+		if featureView.TTL() < 0 {
+			addProblem("feature view %s must not have a negative TTL", name)
+		}
+
+		source := featureView.BatchSource()
+		switch {
+		case source == nil:
+			addProblem("feature view %s must have a batch source", name)
+		case source.GetEventTimestampColumn() == "":
+			addProblem("batch source of feature view %s must have an event timestamp column", name)
+		case source.GetType() == core.DataSource_BATCH_FILE && source.GetFileOptions().GetFileUrl() == "":
+			addProblem("file source of feature view %s must have a path", name)
+		}
+		addFeatures(name, featureView.proto.GetSpec().GetFeatures())
+	}
+
+	for _, featureService := range repo.FeatureServices {
+		name := featureService.Name()
+		switch {
+		case name == "":
+			addProblem("feature service name must not be empty")
+		case defined["feature service "+name]:
+			addProblem("feature service %s is defined more than once", name)
+		}
+		defined["feature service "+name] = true
+
+		if len(featureService.Projections()) == 0 {
+			addProblem("feature service %s must serve at least one feature view", name)
+		}
+		for _, projection := range featureService.Projections() {
+			featureViewFeatures, ok := features[projection.Name()]
+			if !ok {
+				addProblem("feature service %s references undefined feature view %s", name, projection.Name())
+				continue
+			}
+			for _, feature := range projection.Features() {
+				valueType, ok := featureViewFeatures[feature.Name]
+				switch {
+				case !ok:
+					addProblem("feature service %s references undefined feature %s:%s", name, projection.Name(), feature.Name)
+				case valueType != feature.ValueType:
+					addProblem("feature service %s projects feature %s:%s as %s but it is defined as %s",
+						name, projection.Name(), feature.Name, feature.ValueType, valueType)
+				}
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
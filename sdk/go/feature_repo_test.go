@@ -0,0 +1,241 @@
+package feast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Returns a feature repo with a driver entity, feature view and feature service.
+func testFeatureRepo() *FeatureRepo {
+	driver := NewEntity("driver", types.ValueType_INT64).WithJoinKey("driver_id")
+	driverStats := NewFeatureView("driver_stats", []string{"driver"},
+		FileSource{Path: "s3://bucket/driver_stats.parquet", EventTimestampColumn: "event_timestamp"},
+		Feature{Name: "rating", ValueType: types.ValueType_DOUBLE},
+		Feature{Name: "trips", ValueType: types.ValueType_INT64},
+	).WithTTL(24 * time.Hour).WithTags(map[string]string{"team": "drivers"})
+	driverService := NewFeatureService("driver_service", driverStats.Projection("rating").WithAlias("stats"))
+	return &FeatureRepo{
+		Project:         "driver_project",
+		Entities:        []*Entity{driver},
+		FeatureViews:    []*FeatureView{driverStats},
+		FeatureServices: []*FeatureService{driverService},
+	}
+}
+
+func TestBuildRegistry(t *testing.T) {
+	registry, err := testFeatureRepo().BuildRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Timestamps are set at build time.
+	if registry.GetLastUpdated() == nil || registry.GetFeatureViews()[0].GetMeta().GetCreatedTimestamp() == nil {
+		t.Error("Expected registry and object timestamps to be set")
+	}
+	registry.LastUpdated = nil
+	registry.Entities[0].Meta = nil
+	registry.FeatureViews[0].Meta = nil
+	registry.FeatureServices[0].Meta = nil
+
+	want := &core.Registry{
+		RegistrySchemaVersion: "1",
+		Entities: []*core.Entity{{Spec: &core.EntitySpecV2{
+			Name:      "driver",
+			Project:   "driver_project",
+			ValueType: types.ValueType_INT64,
+			JoinKey:   "driver_id",
+		}}},
+		FeatureViews: []*core.FeatureView{{Spec: &core.FeatureViewSpec{
+			Name:     "driver_stats",
+			Project:  "driver_project",
+			Entities: []string{"driver"},
+			Features: []*core.FeatureSpecV2{
+				{Name: "rating", ValueType: types.ValueType_DOUBLE},
+				{Name: "trips", ValueType: types.ValueType_INT64},
+			},
+			Tags:   map[string]string{"team": "drivers"},
+			Ttl:    durationpb.New(24 * time.Hour),
+			Online: true,
+			BatchSource: &core.DataSource{
+				Type:                 core.DataSource_BATCH_FILE,
+				EventTimestampColumn: "event_timestamp",
+				DataSourceClassType:  "feast.infra.offline_stores.file_source.FileSource",
+				Options: &core.DataSource_FileOptions_{FileOptions: &core.DataSource_FileOptions{
+					FileFormat: &core.FileFormat{Format: &core.FileFormat_ParquetFormat_{ParquetFormat: &core.FileFormat_ParquetFormat{}}},
+					FileUrl:    "s3://bucket/driver_stats.parquet",
+				}},
+			},
+		}}},
+		FeatureServices: []*core.FeatureService{{Spec: &core.FeatureServiceSpec{
+			Name:    "driver_service",
+			Project: "driver_project",
+			Features: []*core.FeatureViewProjection{{
+				FeatureViewName:      "driver_stats",
+				FeatureViewNameAlias: "stats",
+				FeatureColumns:       []*core.FeatureSpecV2{{Name: "rating", ValueType: types.ValueType_DOUBLE}},
+			}},
+		}}},
+	}
+	if diff := cmp.Diff(want, registry, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected registry (-want +got):\n%s", diff)
+	}
+}
+
+func TestMergeRegistry(t *testing.T) {
+	created := timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	interval := &core.MaterializationInterval{StartTime: created, EndTime: created}
+	existing := &core.Registry{
+		RegistrySchemaVersion: "1",
+		Entities: []*core.Entity{
+			{Spec: &core.EntitySpecV2{Name: "customer", Project: "driver_project", ValueType: types.ValueType_STRING}},
+			{Spec: &core.EntitySpecV2{Name: "driver", Project: "other_project", ValueType: types.ValueType_STRING}},
+		},
+		FeatureViews: []*core.FeatureView{{
+			Spec: &core.FeatureViewSpec{Name: "driver_stats", Project: "driver_project"},
+			Meta: &core.FeatureViewMeta{CreatedTimestamp: created, MaterializationIntervals: []*core.MaterializationInterval{interval}},
+		}},
+	}
+	original := proto.Clone(existing)
+
+	repo := testFeatureRepo()
+	customerStats := NewFeatureView("customer_stats", []string{"customer"},
+		FileSource{Path: "customer_stats.parquet", EventTimestampColumn: "event_timestamp"},
+		Feature{Name: "orders", ValueType: types.ValueType_INT64},
+	)
+	repo.FeatureViews = append(repo.FeatureViews, customerStats)
+	merged, err := repo.MergeRegistry(existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(existing, original) {
+		t.Error("Expected merging to leave the given registry unchanged")
+	}
+
+	var entities, featureViews []string
+	for _, entity := range merged.GetEntities() {
+		entities = append(entities, entity.GetSpec().GetProject()+"/"+entity.GetSpec().GetName())
+	}
+	for _, featureView := range merged.GetFeatureViews() {
+		featureViews = append(featureViews, featureView.GetSpec().GetProject()+"/"+featureView.GetSpec().GetName())
+	}
+	if diff := cmp.Diff([]string{"driver_project/customer", "other_project/driver", "driver_project/driver"}, entities); diff != "" {
+		t.Errorf("Unexpected entities (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"driver_project/driver_stats", "driver_project/customer_stats"}, featureViews); diff != "" {
+		t.Errorf("Unexpected feature views (-want +got):\n%s", diff)
+	}
+
+	// Replaced feature views keep their creation time and materialization state.
+	meta := merged.GetFeatureViews()[0].GetMeta()
+	if !proto.Equal(meta.GetCreatedTimestamp(), created) {
+		t.Errorf("Expected creation time %v to be preserved, got %v", created, meta.GetCreatedTimestamp())
+	}
+	if len(meta.GetMaterializationIntervals()) != 1 {
+		t.Errorf("Expected materialization intervals to be preserved, got %v", meta.GetMaterializationIntervals())
+	}
+	if len(merged.GetFeatureViews()[0].GetSpec().GetFeatures()) != 2 {
+		t.Error("Expected feature view to be replaced by the repo's definition")
+	}
+}
+
+func TestValidateFeatureRepo(t *testing.T) {
+	tt := []struct {
+		name   string
+		update func(repo *FeatureRepo)
+		want   []string
+	}{
+		{
+			name:   "Valid repo",
+			update: func(repo *FeatureRepo) {},
+		},
+		{
+			name: "Invalid project and entity",
+			update: func(repo *FeatureRepo) {
+				repo.Project = "driver-project"
+				repo.Entities = append(repo.Entities, NewEntity("driver", types.ValueType_INVALID))
+			},
+			want: []string{
+				`invalid project name "driver-project"`,
+				"entity driver is defined more than once",
+				"entity driver must have a value type",
+			},
+		},
+		{
+			name: "Invalid feature view",
+			update: func(repo *FeatureRepo) {
+				repo.FeatureViews = append(repo.FeatureViews, NewFeatureView("vehicle_stats", []string{"vehicle"}, FileSource{},
+					Feature{Name: "mileage"},
+					Feature{Name: "mileage", ValueType: types.ValueType_INT64},
+				).WithTTL(-time.Hour))
+			},
+			want: []string{
+				"feature view vehicle_stats references undefined entity vehicle",
+				"feature vehicle_stats:mileage must have a value type",
+				"feature view vehicle_stats defines feature mileage more than once",
+				"feature view vehicle_stats must not have a negative TTL",
+				"batch source of feature view vehicle_stats must have an event timestamp column",
+			},
+		},
+		{
+			name: "Invalid feature service",
+			update: func(repo *FeatureRepo) {
+				driverStats := repo.FeatureViews[0]
+				mistyped := driverStats.Projection("trips")
+				mistyped.Proto().FeatureColumns[0].ValueType = types.ValueType_STRING
+				repo.FeatureServices = append(repo.FeatureServices,
+					NewFeatureService("vehicle_service", NewFeatureView("vehicle_stats", nil, nil).Projection()),
+					NewFeatureService("stats_service", driverStats.Projection("rating", "acceptance_rate"), mistyped),
+					NewFeatureService("empty_service"),
+				)
+			},
+			want: []string{
+				"feature service vehicle_service references undefined feature view vehicle_stats",
+				"feature service stats_service references undefined feature driver_stats:acceptance_rate",
+				"feature service stats_service projects feature driver_stats:trips as STRING but it is defined as INT64",
+				"feature service empty_service must serve at least one feature view",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := testFeatureRepo()
+			tc.update(repo)
+			err := repo.Validate()
+			var got []string
+			if err != nil {
+				validationErr, ok := err.(*ValidationError)
+				if !ok {
+					t.Fatalf("Expected *ValidationError, got %T: %v", err, err)
+				}
+				got = validationErr.Problems
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected validation problems (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeRegistryReferences(t *testing.T) {
+	// Definitions may reference objects of the project already in the registry.
+	existing := &core.Registry{
+		Entities: []*core.Entity{{Spec: &core.EntitySpecV2{Name: "driver", Project: "driver_project", ValueType: types.ValueType_INT64}}},
+	}
+	repo := testFeatureRepo()
+	repo.Entities = nil
+	if _, err := repo.MergeRegistry(existing); err != nil {
+		t.Errorf("Unexpected error merging repo referencing registry entity: %v", err)
+	}
+	if _, err := repo.BuildRegistry(); err == nil {
+		t.Error("Expected error building registry with undefined entity")
+	}
+}
@@ -0,0 +1,153 @@
+package feast
+
+import (
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+// FeatureService is a wrapper around core.FeatureService.
+type FeatureService struct {
+	proto *core.FeatureService
+}
+
+// NewFeatureServiceFromProto wraps the given FeatureService proto.
+func NewFeatureServiceFromProto(proto *core.FeatureService) *FeatureService {
+	return &FeatureService{proto: proto}
+}
+
+// NewFeatureService defines a feature service serving the given feature view projections.
+func NewFeatureService(name string, projections ...*FeatureViewProjection) *FeatureService {
+	protos := make([]*core.FeatureViewProjection, len(projections))
+	for i, projection := range projections {
+		protos[i] = projection.Proto()
+	}
+	return &FeatureService{proto: &core.FeatureService{Spec: &core.FeatureServiceSpec{
+		Name:     name,
+		Features: protos,
+	}}}
+}
+
+// WithDescription sets the description of the feature service.
+func (fs *FeatureService) WithDescription(description string) *FeatureService {
+	fs.proto.Spec.Description = description
+	return fs
+}
+
+// WithTags sets the user defined metadata of the feature service.
+func (fs *FeatureService) WithTags(tags map[string]string) *FeatureService {
+	fs.proto.Spec.Tags = tags
+	return fs
+}
+
+// Proto returns the underlying FeatureService proto.
+func (fs *FeatureService) Proto() *core.FeatureService {
+	return fs.proto
+}
+
+// Name returns the name of the feature service.
+func (fs *FeatureService) Name() string {
+	return fs.proto.GetSpec().GetName()
+}
+
+// Project returns the name of the project the feature service belongs to.
+func (fs *FeatureService) Project() string {
+	return fs.proto.GetSpec().GetProject()
+}
+
+// Description returns the description of the feature service.
+func (fs *FeatureService) Description() string {
+	return fs.proto.GetSpec().GetDescription()
+}
+
+// Tags returns the user defined metadata of the feature service.
+func (fs *FeatureService) Tags() map[string]string {
+	return fs.proto.GetSpec().GetTags()
+}
+
+// Projections returns the feature view projections served by the feature service.
+func (fs *FeatureService) Projections() []*FeatureViewProjection {
+	protos := fs.proto.GetSpec().GetFeatures()
+	projections := make([]*FeatureViewProjection, len(protos))
+	for i, proto := range protos {
+		projections[i] = NewFeatureViewProjectionFromProto(proto)
+	}
+	return projections
+}
+
+// FeatureRefs returns the references of the features served by the feature service
+// in the format featureViewName:featureName, using feature view aliases where given.
+func (fs *FeatureService) FeatureRefs() []string {
+	var refs []string
+	for _, projection := range fs.Projections() {
+		for _, feature := range projection.Features() {
+			refs = append(refs, projection.NameToUse()+":"+feature.Name)
+		}
+	}
+	return refs
+}
+
+// CreatedTimestamp returns the time the feature service was created, zero if unknown.
+func (fs *FeatureService) CreatedTimestamp() time.Time {
+	return asTime(fs.proto.GetMeta().GetCreatedTimestamp())
+}
+
+// LastUpdatedTimestamp returns the time the feature service was last updated, zero if unknown.
+func (fs *FeatureService) LastUpdatedTimestamp() time.Time {
+	return asTime(fs.proto.GetMeta().GetLastUpdatedTimestamp())
+}
+
+// FeatureViewProjection is a wrapper around core.FeatureViewProjection.
+type FeatureViewProjection struct {
+	proto *core.FeatureViewProjection
+}
+
+// NewFeatureViewProjectionFromProto wraps the given FeatureViewProjection proto.
+func NewFeatureViewProjectionFromProto(proto *core.FeatureViewProjection) *FeatureViewProjection {
+	return &FeatureViewProjection{proto: proto}
+}
+
+// WithAlias sets the alias under which the projected features are served.
+func (p *FeatureViewProjection) WithAlias(alias string) *FeatureViewProjection {
+	p.proto.FeatureViewNameAlias = alias
+	return p
+}
+
+// WithJoinKeyMap sets the overrides mapping feature data join keys to entity data join keys.
+func (p *FeatureViewProjection) WithJoinKeyMap(joinKeyMap map[string]string) *FeatureViewProjection {
+	p.proto.JoinKeyMap = joinKeyMap
+	return p
+}
+
+// Proto returns the underlying FeatureViewProjection proto.
+func (p *FeatureViewProjection) Proto() *core.FeatureViewProjection {
+	return p.proto
+}
+
+// Name returns the name of the projected feature view.
+func (p *FeatureViewProjection) Name() string {
+	return p.proto.GetFeatureViewName()
+}
+
+// NameAlias returns the alias of the projected feature view, empty if unaliased.
+func (p *FeatureViewProjection) NameAlias() string {
+	return p.proto.GetFeatureViewNameAlias()
+}
+
+// NameToUse returns the alias of the projected feature view if given, otherwise its name.
+func (p *FeatureViewProjection) NameToUse() string {
+	if alias := p.NameAlias(); alias != "" {
+		return alias
+	}
+	return p.Name()
+}
+
+// Features returns the projected subset of the feature view's features.
+func (p *FeatureViewProjection) Features() []Feature {
+	return newFeatures(p.proto.GetFeatureColumns())
+}
+
+// JoinKeyMap returns the overrides mapping feature data join keys to entity data join keys.
+func (p *FeatureViewProjection) JoinKeyMap() map[string]string {
+	return p.proto.GetJoinKeyMap()
+}
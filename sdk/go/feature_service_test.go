@@ -0,0 +1,72 @@
+package feast
+
+import (
+	"testing"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFeatureService(t *testing.T) {
+	fs := NewFeatureServiceFromProto(&core.FeatureService{
+		Spec: &core.FeatureServiceSpec{
+			Name:        "driver_service",
+			Description: "Features used by the driver ranking model",
+			Features: []*core.FeatureViewProjection{
+				{
+					FeatureViewName: "driver_stats",
+					FeatureColumns:  []*core.FeatureSpecV2{{Name: "rating", ValueType: types.ValueType_DOUBLE}},
+				},
+				{
+					FeatureViewName:      "driver_stats",
+					FeatureViewNameAlias: "driver_stats_lagged",
+					FeatureColumns:       []*core.FeatureSpecV2{{Name: "trips", ValueType: types.ValueType_INT64}},
+					JoinKeyMap:           map[string]string{"driver_id": "previous_driver_id"},
+				},
+			},
+		},
+	})
+
+	want := []string{"driver_stats:rating", "driver_stats_lagged:trips"}
+	if !cmp.Equal(fs.FeatureRefs(), want) {
+		t.Errorf("Unexpected feature refs: %s", cmp.Diff(want, fs.FeatureRefs()))
+	}
+	projection := fs.Projections()[1]
+	if projection.Name() != "driver_stats" || projection.NameToUse() != "driver_stats_lagged" {
+		t.Errorf("Unexpected projection name %s and name to use %s", projection.Name(), projection.NameToUse())
+	}
+	if projection.JoinKeyMap()["driver_id"] != "previous_driver_id" {
+		t.Errorf("Unexpected join key map: %v", projection.JoinKeyMap())
+	}
+}
+
+func TestEntity(t *testing.T) {
+	tt := []struct {
+		name        string
+		spec        *core.EntitySpecV2
+		wantJoinKey string
+	}{
+		{
+			name:        "Explicit join key",
+			spec:        &core.EntitySpecV2{Name: "driver", JoinKey: "driver_id", ValueType: types.ValueType_INT64},
+			wantJoinKey: "driver_id",
+		},
+		{
+			name:        "Join key defaults to name",
+			spec:        &core.EntitySpecV2{Name: "driver", ValueType: types.ValueType_INT64},
+			wantJoinKey: "driver",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			entity := NewEntityFromProto(&core.Entity{Spec: tc.spec})
+			if entity.JoinKey() != tc.wantJoinKey {
+				t.Errorf("Expected join key %s, got %s", tc.wantJoinKey, entity.JoinKey())
+			}
+			if entity.ValueType() != types.ValueType_INT64 {
+				t.Errorf("Expected INT64 value type, got %v", entity.ValueType())
+			}
+		})
+	}
+}
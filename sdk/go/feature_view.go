@@ -0,0 +1,314 @@
+package feast
+
+import (
+	"sort"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Feature is a typed feature defined by a feature view.
+type Feature struct {
+	Name      string
+	ValueType types.ValueType_Enum
+	Labels    map[string]string
+}
+
+// Builds Features from FeatureSpecV2 protos.
+func newFeatures(specs []*core.FeatureSpecV2) []Feature {
+	features := make([]Feature, len(specs))
+	for i, spec := range specs {
+		features[i] = Feature{Name: spec.GetName(), ValueType: spec.GetValueType(), Labels: spec.GetLabels()}
+	}
+	return features
+}
+
+// Returns the feature with the given name.
+func findFeature(features []Feature, name string) (Feature, bool) {
+	for _, feature := range features {
+		if feature.Name == name {
+			return feature, true
+		}
+	}
+	return Feature{}, false
+}
+
+// MaterializationInterval is a time range for which a feature view has been materialized.
+type MaterializationInterval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// FeatureView is a wrapper around core.FeatureView.
+type FeatureView struct {
+	proto *core.FeatureView
+}
+
+// NewFeatureViewFromProto wraps the given FeatureView proto.
+func NewFeatureViewFromProto(proto *core.FeatureView) *FeatureView {
+	return &FeatureView{proto: proto}
+}
+
+// NewFeatureView defines an online feature view with the given features of the given entities,
+// read from the given batch source. Feature values never expire unless a TTL is set.
+func NewFeatureView(name string, entities []string, batchSource DataSource, features ...Feature) *FeatureView {
+	specs := make([]*core.FeatureSpecV2, len(features))
+	for i, feature := range features {
+		specs[i] = &core.FeatureSpecV2{Name: feature.Name, ValueType: feature.ValueType, Labels: feature.Labels}
+	}
+	spec := &core.FeatureViewSpec{
+		Name:     name,
+		Entities: entities,
+		Features: specs,
+		Ttl:      durationpb.New(0),
+		Online:   true,
+	}
+	if batchSource != nil {
+		spec.BatchSource = batchSource.Proto()
+	}
+	return &FeatureView{proto: &core.FeatureView{Spec: spec}}
+}
+
+// WithTTL sets the maximum age of feature values served online.
+func (fv *FeatureView) WithTTL(ttl time.Duration) *FeatureView {
+	fv.proto.Spec.Ttl = durationpb.New(ttl)
+	return fv
+}
+
+// WithTags sets the user defined metadata of the feature view.
+func (fv *FeatureView) WithTags(tags map[string]string) *FeatureView {
+	fv.proto.Spec.Tags = tags
+	return fv
+}
+
+// WithOnline sets whether the feature view's features are served online.
+func (fv *FeatureView) WithOnline(online bool) *FeatureView {
+	fv.proto.Spec.Online = online
+	return fv
+}
+
+// WithStreamSource sets the streaming data source of the feature view.
+func (fv *FeatureView) WithStreamSource(streamSource DataSource) *FeatureView {
+	fv.proto.Spec.StreamSource = streamSource.Proto()
+	return fv
+}
+
+// Projection returns a projection of the given features of the feature view, or all of its features if none are given.
+// Features the feature view does not define are projected without a value type, failing validation.
+func (fv *FeatureView) Projection(features ...string) *FeatureViewProjection {
+	specs := fv.proto.GetSpec().GetFeatures()
+	if len(features) > 0 {
+		specs = make([]*core.FeatureSpecV2, len(features))
+		for i, name := range features {
+			specs[i] = &core.FeatureSpecV2{Name: name}
+			for _, spec := range fv.proto.GetSpec().GetFeatures() {
+				if spec.GetName() == name {
+					specs[i] = spec
+				}
+			}
+		}
+	}
+	columns := make([]*core.FeatureSpecV2, len(specs))
+	for i, spec := range specs {
+		columns[i] = proto.Clone(spec).(*core.FeatureSpecV2)
+	}
+	return &FeatureViewProjection{proto: &core.FeatureViewProjection{
+		FeatureViewName: fv.Name(),
+		FeatureColumns:  columns,
+	}}
+}
+
+// Proto returns the underlying FeatureView proto.
+func (fv *FeatureView) Proto() *core.FeatureView {
+	return fv.proto
+}
+
+// Name returns the name of the feature view.
+func (fv *FeatureView) Name() string {
+	return fv.proto.GetSpec().GetName()
+}
+
+// Project returns the name of the project the feature view belongs to.
+func (fv *FeatureView) Project() string {
+	return fv.proto.GetSpec().GetProject()
+}
+
+// Entities returns the names of the entities the feature view's features are associated with.
+func (fv *FeatureView) Entities() []string {
+	return fv.proto.GetSpec().GetEntities()
+}
+
+// Features returns the features defined by the feature view.
+func (fv *FeatureView) Features() []Feature {
+	return newFeatures(fv.proto.GetSpec().GetFeatures())
+}
+
+// Feature returns the feature of the feature view with the given name.
+func (fv *FeatureView) Feature(name string) (Feature, bool) {
+	return findFeature(fv.Features(), name)
+}
+
+// TTL returns the maximum age of feature values served online. Zero means feature values never expire.
+func (fv *FeatureView) TTL() time.Duration {
+	ttl := fv.proto.GetSpec().GetTtl()
+	if ttl == nil {
+		return 0
+	}
+	return ttl.AsDuration()
+}
+
+// Tags returns the user defined metadata of the feature view.
+func (fv *FeatureView) Tags() map[string]string {
+	return fv.proto.GetSpec().GetTags()
+}
+
+// Online returns whether the feature view's features are served online.
+func (fv *FeatureView) Online() bool {
+	return fv.proto.GetSpec().GetOnline()
+}
+
+// BatchSource returns the offline data source of the feature view.
+func (fv *FeatureView) BatchSource() *core.DataSource {
+	return fv.proto.GetSpec().GetBatchSource()
+}
+
+// StreamSource returns the streaming data source of the feature view, nil if unspecified.
+func (fv *FeatureView) StreamSource() *core.DataSource {
+	return fv.proto.GetSpec().GetStreamSource()
+}
+
+// CreatedTimestamp returns the time the feature view was created, zero if unknown.
+func (fv *FeatureView) CreatedTimestamp() time.Time {
+	return asTime(fv.proto.GetMeta().GetCreatedTimestamp())
+}
+
+// LastUpdatedTimestamp returns the time the feature view was last updated, zero if unknown.
+func (fv *FeatureView) LastUpdatedTimestamp() time.Time {
+	return asTime(fv.proto.GetMeta().GetLastUpdatedTimestamp())
+}
+
+// MaterializationIntervals returns the time ranges the feature view has been materialized for.
+func (fv *FeatureView) MaterializationIntervals() []MaterializationInterval {
+	protos := fv.proto.GetMeta().GetMaterializationIntervals()
+	intervals := make([]MaterializationInterval, len(protos))
+	for i, interval := range protos {
+		intervals[i] = MaterializationInterval{
+			Start: asTime(interval.GetStartTime()),
+			End:   asTime(interval.GetEndTime()),
+		}
+	}
+	return intervals
+}
+
+// OnDemandFeatureView is a wrapper around core.OnDemandFeatureView.
+type OnDemandFeatureView struct {
+	proto *core.OnDemandFeatureView
+}
+
+// NewOnDemandFeatureViewFromProto wraps the given OnDemandFeatureView proto.
+func NewOnDemandFeatureViewFromProto(proto *core.OnDemandFeatureView) *OnDemandFeatureView {
+	return &OnDemandFeatureView{proto: proto}
+}
+
+// Proto returns the underlying OnDemandFeatureView proto.
+func (odfv *OnDemandFeatureView) Proto() *core.OnDemandFeatureView {
+	return odfv.proto
+}
+
+// Name returns the name of the on demand feature view.
+func (odfv *OnDemandFeatureView) Name() string {
+	return odfv.proto.GetSpec().GetName()
+}
+
+// Project returns the name of the project the on demand feature view belongs to.
+func (odfv *OnDemandFeatureView) Project() string {
+	return odfv.proto.GetSpec().GetProject()
+}
+
+// Features returns the features computed by the on demand feature view.
+func (odfv *OnDemandFeatureView) Features() []Feature {
+	return newFeatures(odfv.proto.GetSpec().GetFeatures())
+}
+
+// Feature returns the feature of the on demand feature view with the given name.
+func (odfv *OnDemandFeatureView) Feature(name string) (Feature, bool) {
+	return findFeature(odfv.Features(), name)
+}
+
+// FeatureViewInputs returns the names of the feature views used as inputs, sorted by name.
+func (odfv *OnDemandFeatureView) FeatureViewInputs() []string {
+	var names []string
+	for _, input := range odfv.proto.GetSpec().GetInputs() {
+		if fv := input.GetFeatureView(); fv != nil {
+			names = append(names, fv.GetSpec().GetName())
+		} else if projection := input.GetFeatureViewProjection(); projection != nil {
+			names = append(names, projection.GetFeatureViewName())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RequestDataSources returns the request data sources used as inputs, keyed by input name.
+func (odfv *OnDemandFeatureView) RequestDataSources() map[string]*core.DataSource {
+	sources := make(map[string]*core.DataSource)
+	for name, input := range odfv.proto.GetSpec().GetInputs() {
+		if source := input.GetRequestDataSource(); source != nil {
+			sources[name] = source
+		}
+	}
+	return sources
+}
+
+// RequestFeatureView is a wrapper around core.RequestFeatureView.
+type RequestFeatureView struct {
+	proto *core.RequestFeatureView
+}
+
+// NewRequestFeatureViewFromProto wraps the given RequestFeatureView proto.
+func NewRequestFeatureViewFromProto(proto *core.RequestFeatureView) *RequestFeatureView {
+	return &RequestFeatureView{proto: proto}
+}
+
+// Proto returns the underlying RequestFeatureView proto.
+func (rfv *RequestFeatureView) Proto() *core.RequestFeatureView {
+	return rfv.proto
+}
+
+// Name returns the name of the request feature view.
+func (rfv *RequestFeatureView) Name() string {
+	return rfv.proto.GetSpec().GetName()
+}
+
+// Project returns the name of the project the request feature view belongs to.
+func (rfv *RequestFeatureView) Project() string {
+	return rfv.proto.GetSpec().GetProject()
+}
+
+// RequestDataSource returns the request data source of the request feature view.
+func (rfv *RequestFeatureView) RequestDataSource() *core.DataSource {
+	return rfv.proto.GetSpec().GetRequestDataSource()
+}
+
+// Features returns the features defined by the schema of the request data source, sorted by name.
+func (rfv *RequestFeatureView) Features() []Feature {
+	schema := rfv.RequestDataSource().GetRequestDataOptions().GetSchema()
+	features := make([]Feature, 0, len(schema))
+	for name, valueType := range schema {
+		features = append(features, Feature{Name: name, ValueType: valueType})
+	}
+	sort.Slice(features, func(i, j int) bool { return features[i].Name < features[j].Name })
+	return features
+}
+
+// Converts a timestamp proto to a time, returning the zero time if unset.
+func asTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,126 @@
+package feast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFeatureView(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	fv := NewFeatureViewFromProto(&core.FeatureView{
+		Spec: &core.FeatureViewSpec{
+			Name:     "driver_stats",
+			Project:  "driver_project",
+			Entities: []string{"driver"},
+			Features: []*core.FeatureSpecV2{
+				{Name: "rating", ValueType: types.ValueType_DOUBLE},
+				{Name: "trips", ValueType: types.ValueType_INT64, Labels: map[string]string{"owner": "team-a"}},
+			},
+			Ttl:    durationpb.New(2 * time.Hour),
+			Online: true,
+			BatchSource: &core.DataSource{
+				Type:    core.DataSource_BATCH_FILE,
+				Options: &core.DataSource_FileOptions_{FileOptions: &core.DataSource_FileOptions{FileUrl: "driver_stats.parquet"}},
+			},
+		},
+		Meta: &core.FeatureViewMeta{
+			MaterializationIntervals: []*core.MaterializationInterval{
+				{StartTime: timestamppb.New(start), EndTime: timestamppb.New(end)},
+			},
+		},
+	})
+
+	if fv.Name() != "driver_stats" || fv.Project() != "driver_project" || !fv.Online() {
+		t.Errorf("Unexpected feature view name, project or online flag: %s, %s, %v", fv.Name(), fv.Project(), fv.Online())
+	}
+	if !cmp.Equal(fv.Entities(), []string{"driver"}) {
+		t.Errorf("Expected entities [driver], got %v", fv.Entities())
+	}
+	wantFeatures := []Feature{
+		{Name: "rating", ValueType: types.ValueType_DOUBLE},
+		{Name: "trips", ValueType: types.ValueType_INT64, Labels: map[string]string{"owner": "team-a"}},
+	}
+	if !cmp.Equal(fv.Features(), wantFeatures) {
+		t.Errorf("Unexpected features: %s", cmp.Diff(wantFeatures, fv.Features()))
+	}
+	if feature, ok := fv.Feature("trips"); !ok || feature.ValueType != types.ValueType_INT64 {
+		t.Errorf("Expected to find INT64 feature trips, got %v", feature)
+	}
+	if _, ok := fv.Feature("missing"); ok {
+		t.Error("Expected missing feature not to be found")
+	}
+	if fv.TTL() != 2*time.Hour {
+		t.Errorf("Expected TTL of 2h, got %v", fv.TTL())
+	}
+	if fv.BatchSource().GetFileOptions().GetFileUrl() != "driver_stats.parquet" || fv.StreamSource() != nil {
+		t.Errorf("Unexpected sources: %v, %v", fv.BatchSource(), fv.StreamSource())
+	}
+	wantIntervals := []MaterializationInterval{{Start: start, End: end}}
+	if !cmp.Equal(fv.MaterializationIntervals(), wantIntervals) {
+		t.Errorf("Unexpected materialization intervals: %v", fv.MaterializationIntervals())
+	}
+	if !fv.CreatedTimestamp().IsZero() {
+		t.Errorf("Expected zero created timestamp for missing meta, got %v", fv.CreatedTimestamp())
+	}
+
+	// Accessors should be safe on empty protos.
+	empty := NewFeatureViewFromProto(&core.FeatureView{})
+	if empty.Name() != "" || empty.TTL() != 0 || len(empty.Features()) != 0 || len(empty.MaterializationIntervals()) != 0 {
+		t.Error("Expected zero values for empty feature view")
+	}
+}
+
+func TestOnDemandFeatureView(t *testing.T) {
+	odfv := NewOnDemandFeatureViewFromProto(&core.OnDemandFeatureView{
+		Spec: &core.OnDemandFeatureViewSpec{
+			Name:     "driver_adjusted",
+			Features: []*core.FeatureSpecV2{{Name: "adjusted_rating", ValueType: types.ValueType_DOUBLE}},
+			Inputs: map[string]*core.OnDemandInput{
+				"driver_stats": {Input: &core.OnDemandInput_FeatureViewProjection{
+					FeatureViewProjection: &core.FeatureViewProjection{FeatureViewName: "driver_stats"},
+				}},
+				"customer_stats": {Input: &core.OnDemandInput_FeatureView{
+					FeatureView: &core.FeatureView{Spec: &core.FeatureViewSpec{Name: "customer_stats"}},
+				}},
+				"request": {Input: &core.OnDemandInput_RequestDataSource{
+					RequestDataSource: &core.DataSource{Type: core.DataSource_REQUEST_SOURCE},
+				}},
+			},
+		},
+	})
+	if !cmp.Equal(odfv.FeatureViewInputs(), []string{"customer_stats", "driver_stats"}) {
+		t.Errorf("Unexpected feature view inputs: %v", odfv.FeatureViewInputs())
+	}
+	if sources := odfv.RequestDataSources(); len(sources) != 1 || sources["request"] == nil {
+		t.Errorf("Unexpected request data sources: %v", sources)
+	}
+	if _, ok := odfv.Feature("adjusted_rating"); !ok {
+		t.Error("Expected to find feature adjusted_rating")
+	}
+}
+
+func TestRequestFeatureView(t *testing.T) {
+	rfv := NewRequestFeatureViewFromProto(&core.RequestFeatureView{
+		Spec: &core.RequestFeatureViewSpec{
+			Name: "request_view",
+			RequestDataSource: &core.DataSource{
+				Type: core.DataSource_REQUEST_SOURCE,
+				Options: &core.DataSource_RequestDataOptions_{RequestDataOptions: &core.DataSource_RequestDataOptions{
+					Name:   "request_source",
+					Schema: map[string]types.ValueType_Enum{"val_to_add": types.ValueType_INT64, "amount": types.ValueType_DOUBLE},
+				}},
+			},
+		},
+	})
+	want := []Feature{{Name: "amount", ValueType: types.ValueType_DOUBLE}, {Name: "val_to_add", ValueType: types.ValueType_INT64}}
+	if !cmp.Equal(rfv.Features(), want) {
+		t.Errorf("Unexpected features: %s", cmp.Diff(want, rfv.Features()))
+	}
+}
@@ -0,0 +1,386 @@
+package feast
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/schema"
+	"github.com/xitongsys/parquet-go/source"
+	parquettypes "github.com/xitongsys/parquet-go/types"
+)
+
+// Number of rows read from parquet files at once.
+const parquetBatchSize = 1024
+
+// GetHistoricalFeatures retrieves the point in time correct values of the given features (ie. "driver_stats:rating")
+// for the entity rows of the entity source, which must hold the entity columns and an event timestamp column.
+// It waits for the retrieval job to finish and returns an iterator over the rows of its output, which must be
+// written as parquet to a local path or file:// URI. Each row holds the columns of the entity source and the
+// retrieved features, with null values being empty Values.
+func (c *JobClient) GetHistoricalFeatures(ctx context.Context, featureRefs []string, entitySource DataSource) (*RowIterator, error) {
+	job, err := c.StartHistoricalRetrieval(ctx, &core.GetHistoricalFeaturesRequest{
+		FeatureRefs:  featureRefs,
+		EntitySource: entitySource.Proto(),
+		OutputFormat: "parquet",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := job.Wait(ctx); err != nil {
+		return nil, err
+	}
+	if job.OutputLocation() == "" {
+		return nil, fmt.Errorf("retrieval job %s did not report an output location", job.ID())
+	}
+	return OpenParquetRows(job.OutputLocation())
+}
+
+// RowIterator iterates over the rows of parquet files:
+//
+//	for it.Next() {
+//		row := it.Row()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type RowIterator struct {
+	files   []string
+	file    source.ParquetFile
+	reader  *reader.ParquetReader
+	columns []parquetColumn
+	// Rows of the current batch and the number of rows left to read from the current file.
+	batch   []interface{}
+	pending int64
+	row     Row
+	err     error
+}
+
+// OpenParquetRows returns an iterator over the rows of the parquet file or directory of parquet files at the given
+// local path or file:// URI. Files of a directory are read in name order, skipping hidden files such as _SUCCESS.
+func OpenParquetRows(location string) (*RowIterator, error) {
+	path := location
+	if strings.HasPrefix(location, "file://") {
+		path = strings.TrimPrefix(location, "file://")
+	} else if strings.Contains(location, "://") {
+		return nil, fmt.Errorf("unsupported output location %s, only local paths and file:// URIs can be read", location)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		files = nil
+		for _, entry := range entries {
+			if !entry.IsDir() && !strings.HasPrefix(entry.Name(), "_") && !strings.HasPrefix(entry.Name(), ".") {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+		sort.Strings(files)
+	}
+	return &RowIterator{files: files}, nil
+}
+
+// Next advances the iterator to the next row, returning false when there are no more rows or an error occurred.
+func (it *RowIterator) Next() bool {
+	for it.err == nil && len(it.batch) == 0 {
+		if it.pending == 0 {
+			if len(it.files) == 0 {
+				it.err = it.closeFile()
+				return false
+			}
+			it.err = it.openFile(it.files[0])
+			it.files = it.files[1:]
+			continue
+		}
+		size := parquetBatchSize
+		if it.pending < int64(size) {
+			size = int(it.pending)
+		}
+		it.batch, it.err = it.reader.ReadByNumber(size)
+		it.pending -= int64(size)
+	}
+	if it.err != nil {
+		it.closeFile()
+		return false
+	}
+
+	record := reflect.Indirect(reflect.ValueOf(it.batch[0]))
+	it.batch = it.batch[1:]
+	it.row = make(Row, len(it.columns))
+	for i, column := range it.columns {
+		it.row[column.name] = column.value(record.Field(i))
+	}
+	return true
+}
+
+// Row returns the current row.
+func (it *RowIterator) Row() Row {
+	return it.row
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *RowIterator) Err() error {
+	return it.err
+}
+
+// Close releases the file being read. Iterators read to the end or stopped by an error are closed automatically.
+func (it *RowIterator) Close() error {
+	it.files = nil
+	it.pending = 0
+	it.batch = nil
+	return it.closeFile()
+}
+
+func (it *RowIterator) openFile(path string) error {
+	if err := it.closeFile(); err != nil {
+		return err
+	}
+	file, err := local.NewLocalFileReader(path)
+	if err != nil {
+		return err
+	}
+	parquetReader, err := reader.NewParquetReader(file, nil, 1)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to read parquet file %s: %v", path, err)
+	}
+	columns, err := parquetColumns(parquetReader.SchemaHandler)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to read parquet file %s: %v", path, err)
+	}
+	it.file, it.reader, it.columns, it.pending = file, parquetReader, columns, parquetReader.GetNumRows()
+	if it.pending == 0 {
+		return it.closeFile()
+	}
+	return nil
+}
+
+func (it *RowIterator) closeFile() error {
+	if it.file == nil {
+		return nil
+	}
+	it.reader.ReadStop()
+	err := it.file.Close()
+	it.file, it.reader = nil, nil
+	return err
+}
+
+// Kinds of parquet values, determining the Value they are converted to.
+type parquetKind int
+
+const (
+	parquetInt32 parquetKind = iota
+	parquetInt64
+	parquetFloat
+	parquetDouble
+	parquetBool
+	parquetString
+	parquetBytes
+	parquetTimestamp
+)
+
+// parquetColumn is a top level column of a parquet file.
+type parquetColumn struct {
+	name string
+	kind parquetKind
+	list bool
+	// Converts raw timestamp values, int64 or INT96 strings, to time.
+	toTime func(value reflect.Value) time.Time
+}
+
+// Returns the top level columns of the parquet schema, in the order of the fields of rows read by parquet-go.
+// Only flat schemas of primitive and list columns are supported.
+func parquetColumns(schemaHandler *schema.SchemaHandler) ([]parquetColumn, error) {
+	elements := schemaHandler.SchemaElements
+	// Returns the index of the next element that is not a descendant of the element at index i.
+	var next func(i int) int
+	next = func(i int) int {
+		j := i + 1
+		for c := int32(0); c < elements[i].GetNumChildren() && j < len(elements); c++ {
+			j = next(j)
+		}
+		return j
+	}
+
+	var columns []parquetColumn
+	for i := 1; i < len(elements); i = next(i) {
+		element := elements[i]
+		// Element names are capitalized by parquet-go, the names of the file's columns are kept as external names.
+		column := parquetColumn{name: schemaHandler.GetExName(i)}
+		leaf := element
+		if element.GetNumChildren() > 0 {
+			// Lists are written as a group holding a repeated group holding the element.
+			if element.GetConvertedType() != parquet.ConvertedType_LIST || i+2 >= len(elements) ||
+				element.GetNumChildren() != 1 || elements[i+1].GetNumChildren() != 1 || elements[i+2].GetNumChildren() != 0 {
+				return nil, fmt.Errorf("unsupported nested column %s", column.name)
+			}
+			leaf, column.list = elements[i+2], true
+		} else if element.GetRepetitionType() == parquet.FieldRepetitionType_REPEATED {
+			column.list = true
+		}
+
+		switch leaf.GetType() {
+		case parquet.Type_BOOLEAN:
+			column.kind = parquetBool
+		case parquet.Type_INT32:
+			column.kind = parquetInt32
+		case parquet.Type_INT64:
+			column.kind = parquetInt64
+			column.toTime = int64Timestamp(leaf)
+			if column.toTime != nil {
+				column.kind = parquetTimestamp
+			}
+		case parquet.Type_INT96:
+			column.kind = parquetTimestamp
+			column.toTime = func(value reflect.Value) time.Time { return parquettypes.INT96ToTime(value.String()) }
+		case parquet.Type_FLOAT:
+			column.kind = parquetFloat
+		case parquet.Type_DOUBLE:
+			column.kind = parquetDouble
+		case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+			column.kind = parquetBytes
+			if leaf.GetConvertedType() == parquet.ConvertedType_UTF8 || (leaf.IsSetLogicalType() && leaf.GetLogicalType().IsSetSTRING()) {
+				column.kind = parquetString
+			}
+		default:
+			return nil, fmt.Errorf("unsupported type %s of column %s", leaf.GetType(), column.name)
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// Returns the conversion of int64 timestamps of the given element to time, or nil if it does not hold timestamps.
+func int64Timestamp(element *parquet.SchemaElement) func(value reflect.Value) time.Time {
+	unit := ""
+	switch {
+	case element.IsSetLogicalType() && element.GetLogicalType().IsSetTIMESTAMP():
+		timeUnit := element.GetLogicalType().GetTIMESTAMP().GetUnit()
+		switch {
+		case timeUnit.IsSetMILLIS():
+			unit = "millis"
+		case timeUnit.IsSetMICROS():
+			unit = "micros"
+		case timeUnit.IsSetNANOS():
+			unit = "nanos"
+		}
+	case element.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MILLIS:
+		unit = "millis"
+	case element.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MICROS:
+		unit = "micros"
+	}
+	switch unit {
+	case "millis":
+		return func(value reflect.Value) time.Time { return parquettypes.TIMESTAMP_MILLISToTime(value.Int(), true) }
+	case "micros":
+		return func(value reflect.Value) time.Time { return parquettypes.TIMESTAMP_MICROSToTime(value.Int(), true) }
+	case "nanos":
+		return func(value reflect.Value) time.Time { return parquettypes.TIMESTAMP_NANOSToTime(value.Int(), true) }
+	default:
+		return nil
+	}
+}
+
+// Converts the value of the column read by parquet-go to a Value. Timestamps are converted to unix timestamps.
+func (column parquetColumn) value(value reflect.Value) *types.Value {
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return &types.Value{}
+		}
+		value = value.Elem()
+	}
+	if !column.list {
+		switch column.kind {
+		case parquetInt32:
+			return Int32Val(int32(value.Int()))
+		case parquetInt64:
+			return Int64Val(value.Int())
+		case parquetFloat:
+			return FloatVal(float32(value.Float()))
+		case parquetDouble:
+			return DoubleVal(value.Float())
+		case parquetBool:
+			return BoolVal(value.Bool())
+		case parquetString:
+			return StrVal(value.String())
+		case parquetBytes:
+			return BytesVal([]byte(value.String()))
+		default:
+			return &types.Value{Val: &types.Value_UnixTimestampVal{UnixTimestampVal: column.toTime(value).Unix()}}
+		}
+	}
+
+	// Elements of lists are never null in Feast.
+	elements := make([]reflect.Value, value.Len())
+	for i := range elements {
+		elements[i] = reflect.Indirect(value.Index(i))
+	}
+	switch column.kind {
+	case parquetInt32:
+		list := &types.Int32List{Val: make([]int32, len(elements))}
+		for i, element := range elements {
+			list.Val[i] = int32(element.Int())
+		}
+		return &types.Value{Val: &types.Value_Int32ListVal{Int32ListVal: list}}
+	case parquetInt64:
+		list := &types.Int64List{Val: make([]int64, len(elements))}
+		for i, element := range elements {
+			list.Val[i] = element.Int()
+		}
+		return &types.Value{Val: &types.Value_Int64ListVal{Int64ListVal: list}}
+	case parquetFloat:
+		list := &types.FloatList{Val: make([]float32, len(elements))}
+		for i, element := range elements {
+			list.Val[i] = float32(element.Float())
+		}
+		return &types.Value{Val: &types.Value_FloatListVal{FloatListVal: list}}
+	case parquetDouble:
+		list := &types.DoubleList{Val: make([]float64, len(elements))}
+		for i, element := range elements {
+			list.Val[i] = element.Float()
+		}
+		return &types.Value{Val: &types.Value_DoubleListVal{DoubleListVal: list}}
+	case parquetBool:
+		list := &types.BoolList{Val: make([]bool, len(elements))}
+		for i, element := range elements {
+			list.Val[i] = element.Bool()
+		}
+		return &types.Value{Val: &types.Value_BoolListVal{BoolListVal: list}}
+	case parquetString:
+		list := &types.StringList{Val: make([]string, len(elements))}
+		for i, element := range elements {
+			list.Val[i] = element.String()
+		}
+		return &types.Value{Val: &types.Value_StringListVal{StringListVal: list}}
+	case parquetBytes:
+		list := &types.BytesList{Val: make([][]byte, len(elements))}
+		for i, element := range elements {
+			list.Val[i] = []byte(element.String())
+		}
+		return &types.Value{Val: &types.Value_BytesListVal{BytesListVal: list}}
+	default:
+		list := &types.Int64List{Val: make([]int64, len(elements))}
+		for i, element := range elements {
+			list.Val[i] = column.toTime(element).Unix()
+		}
+		return &types.Value{Val: &types.Value_UnixTimestampListVal{UnixTimestampListVal: list}}
+	}
+}
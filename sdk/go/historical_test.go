@@ -0,0 +1,117 @@
+package feast
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/writer"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+type historicalRow struct {
+	DriverID       int64    `parquet:"name=driver_id, type=INT64"`
+	EventTimestamp int64    `parquet:"name=event_timestamp, type=INT64, convertedtype=TIMESTAMP_MICROS"`
+	Rating         *float64 `parquet:"name=driver_stats__rating, type=DOUBLE, repetitiontype=OPTIONAL"`
+	City           string   `parquet:"name=driver_stats__city, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Trips          []int32  `parquet:"name=driver_stats__trips, type=LIST, valuetype=INT32"`
+}
+
+// Writes the rows to a parquet file at the given path.
+func writeHistoricalRows(t *testing.T, path string, rows []historicalRow) {
+	file, err := local.NewLocalFileWriter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parquetWriter, err := writer.NewParquetWriter(file, new(historicalRow), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err := parquetWriter.Write(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := parquetWriter.WriteStop(); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+}
+
+func TestGetHistoricalFeatures(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "output")
+	if err := os.Mkdir(output, 0755); err != nil {
+		t.Fatal(err)
+	}
+	rating := 4.5
+	eventTime := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	writeHistoricalRows(t, filepath.Join(output, "part-00000.parquet"), []historicalRow{
+		{DriverID: 1, EventTimestamp: eventTime.UnixNano() / 1000, Rating: &rating, City: "Austin", Trips: []int32{3, 5}},
+	})
+	writeHistoricalRows(t, filepath.Join(output, "part-00001.parquet"), []historicalRow{
+		{DriverID: 2, EventTimestamp: eventTime.UnixNano() / 1000, City: "Dallas"},
+	})
+	if err := ioutil.WriteFile(filepath.Join(output, "_SUCCESS"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	service := &fakeJobService{states: []*core.Job{jobState(core.JobStatus_JOB_STATUS_DONE)}}
+	client := newJobClient(service, nil).WithPollInterval(time.Millisecond, time.Millisecond)
+	entitySource := FileSource{Path: filepath.Join(dir, "entities.parquet"), EventTimestampColumn: "event_timestamp"}
+	featureRefs := []string{"driver_stats:rating", "driver_stats:city", "driver_stats:trips"}
+	if _, err := client.GetHistoricalFeatures(context.Background(), featureRefs, entitySource); err == nil {
+		t.Fatal("Expected an error for a job without output location")
+	}
+
+	service.outputLocation = "file://" + output
+	it, err := client.GetHistoricalFeatures(context.Background(), featureRefs, entitySource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+	var rows []Row
+	for it.Next() {
+		rows = append(rows, it.Row())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if it.file != nil {
+		t.Error("Expected the last file to be closed once read to the end")
+	}
+
+	timestamp := &types.Value{Val: &types.Value_UnixTimestampVal{UnixTimestampVal: eventTime.Unix()}}
+	want := []Row{
+		{
+			"driver_id":            Int64Val(1),
+			"event_timestamp":      timestamp,
+			"driver_stats__rating": DoubleVal(4.5),
+			"driver_stats__city":   StrVal("Austin"),
+			"driver_stats__trips":  {Val: &types.Value_Int32ListVal{Int32ListVal: &types.Int32List{Val: []int32{3, 5}}}},
+		},
+		{
+			"driver_id":            Int64Val(2),
+			"event_timestamp":      timestamp,
+			"driver_stats__rating": {},
+			"driver_stats__city":   StrVal("Dallas"),
+			"driver_stats__trips":  {Val: &types.Value_Int32ListVal{Int32ListVal: &types.Int32List{Val: []int32{}}}},
+		},
+	}
+	if diff := cmp.Diff(want, rows, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected rows (-want +got):\n%s", diff)
+	}
+}
+
+func TestOpenParquetRowsUnsupportedLocation(t *testing.T) {
+	if _, err := OpenParquetRows("gs://bucket/output"); err == nil {
+		t.Error("Expected an error for a gs:// location")
+	}
+}
@@ -0,0 +1,57 @@
+// Package murmur3 implements the 32 bit variant of the MurmurHash3 hash function, as used by Feast to hash
+// feature names and feature table specs.
+package murmur3
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	c1 = 0xcc9e2d51
+	c2 = 0x1b873593
+)
+
+// Sum32 returns the MurmurHash3 x86 32 bit hash of data with a seed of 0, as computed by Guava's murmur3_32 and
+// Python's mmh3.hash.
+func Sum32(data []byte) uint32 {
+	return Sum32WithSeed(data, 0)
+}
+
+// Sum32WithSeed returns the MurmurHash3 x86 32 bit hash of data with the given seed.
+func Sum32WithSeed(data []byte, seed uint32) uint32 {
+	h := seed
+	n := len(data) / 4 * 4
+	for i := 0; i < n; i += 4 {
+		h ^= mixK(binary.LittleEndian.Uint32(data[i:]))
+		h = bits.RotateLeft32(h, 13)
+		h = h*5 + 0xe6546b64
+	}
+
+	var k uint32
+	switch len(data) - n {
+	case 3:
+		k ^= uint32(data[n+2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(data[n+1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(data[n])
+		h ^= mixK(k)
+	}
+
+	h ^= uint32(len(data))
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
+
+func mixK(k uint32) uint32 {
+	k *= c1
+	k = bits.RotateLeft32(k, 15)
+	return k * c2
+}
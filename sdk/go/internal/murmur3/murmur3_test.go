@@ -0,0 +1,25 @@
+package murmur3
+
+import "testing"
+
+func TestSum32(t *testing.T) {
+	// Reference values of the SMHasher implementation of MurmurHash3_x86_32.
+	tt := []struct {
+		data string
+		seed uint32
+		want uint32
+	}{
+		{data: "", seed: 0, want: 0},
+		{data: "", seed: 1, want: 0x514e28b7},
+		{data: "hello", seed: 0, want: 0x248bfa47},
+		{data: "The quick brown fox jumps over the lazy dog", seed: 0, want: 0x2e4ff723},
+		{data: "driver_stats:rating", seed: 0, want: 0x96f0cc87},
+	}
+	for _, tc := range tt {
+		t.Run(tc.data, func(t *testing.T) {
+			if got := Sum32WithSeed([]byte(tc.data), tc.seed); got != tc.want {
+				t.Errorf("got %08x, want %08x", got, tc.want)
+			}
+		})
+	}
+}
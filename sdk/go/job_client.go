@@ -0,0 +1,287 @@
+package feast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Interval after which a job is first polled by Job.Wait.
+	defaultInitialPollInterval = time.Second
+	// Maximum interval between polls of a job by Job.Wait.
+	defaultMaxPollInterval = 30 * time.Second
+)
+
+// JobError is returned when waiting for a job that failed. The job service reports no failure message, so the
+// error holds the details of the job known to the client.
+type JobError struct {
+	JobID  string
+	Type   core.JobType
+	Status core.JobStatus
+	// Project and name of the feature table ingested by the job, if started by the client.
+	Project      string
+	FeatureTable string
+	// Location the output of a retrieval job was to be written to.
+	OutputLocation string
+	// Last error polling the job, if any poll failed.
+	PollError error
+}
+
+func (e *JobError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "feast job %s (%s)", e.JobID, e.Type)
+	if e.FeatureTable != "" {
+		fmt.Fprintf(&b, " of feature table %s/%s", e.Project, e.FeatureTable)
+	}
+	fmt.Fprintf(&b, " failed with status %s", e.Status)
+	if e.OutputLocation != "" {
+		fmt.Fprintf(&b, ", output location %s", e.OutputLocation)
+	}
+	if e.PollError != nil {
+		fmt.Fprintf(&b, ", last poll error: %v", e.PollError)
+	}
+	return b.String()
+}
+
+// JobClient starts and tracks ingestion and retrieval jobs through the Feast job service.
+type JobClient struct {
+	cli                 core.JobServiceClient
+	conn                *grpc.ClientConn
+	initialPollInterval time.Duration
+	maxPollInterval     time.Duration
+}
+
+// NewJobClient constructs a client for the Feast job service at the given host:port.
+// The security config is applied as for serving clients, see NewSecureGrpcClient.
+// opts - grpc.DialOptions which should be used with this connection
+func NewJobClient(host string, port int, security SecurityConfig, opts ...grpc.DialOption) (*JobClient, error) {
+	conn, err := dial(host, port, security, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newJobClient(core.NewJobServiceClient(conn), conn), nil
+}
+
+func newJobClient(cli core.JobServiceClient, conn *grpc.ClientConn) *JobClient {
+	return &JobClient{
+		cli:                 cli,
+		conn:                conn,
+		initialPollInterval: defaultInitialPollInterval,
+		maxPollInterval:     defaultMaxPollInterval,
+	}
+}
+
+// WithPollInterval sets the interval after which Job.Wait first polls a job, doubling after each poll up to max.
+func (c *JobClient) WithPollInterval(initial time.Duration, max time.Duration) *JobClient {
+	c.initialPollInterval = initial
+	c.maxPollInterval = max
+	return c
+}
+
+// Close the grpc connection.
+func (c *JobClient) Close() error {
+	return c.conn.Close()
+}
+
+// StartOfflineToOnlineIngestion starts a job ingesting the rows of the feature table with event timestamps
+// between start and end from its batch source into the online store.
+func (c *JobClient) StartOfflineToOnlineIngestion(ctx context.Context, project string, table string, start time.Time, end time.Time) (*Job, error) {
+	resp, err := c.cli.StartOfflineToOnlineIngestionJob(ctx, &core.StartOfflineToOnlineIngestionJobRequest{
+		Project:   project,
+		TableName: table,
+		StartDate: timestamppb.New(start),
+		EndDate:   timestamppb.New(end),
+	})
+	if err != nil {
+		return nil, coreError("StartOfflineToOnlineIngestionJob", err)
+	}
+	job := c.newJob(resp.GetId(), core.JobType_BATCH_INGESTION_JOB)
+	job.project, job.table = project, table
+	return job, nil
+}
+
+// StartStreamToOnlineIngestion starts a job ingesting the stream source of the feature table into the online store.
+func (c *JobClient) StartStreamToOnlineIngestion(ctx context.Context, project string, table string) (*Job, error) {
+	resp, err := c.cli.StartStreamToOnlineIngestionJob(ctx, &core.StartStreamToOnlineIngestionJobRequest{
+		Project:   project,
+		TableName: table,
+	})
+	if err != nil {
+		return nil, coreError("StartStreamToOnlineIngestionJob", err)
+	}
+	job := c.newJob(resp.GetId(), core.JobType_STREAM_INGESTION_JOB)
+	job.project, job.table = project, table
+	return job, nil
+}
+
+// StartHistoricalRetrieval starts a job retrieving historical feature values, written to the output location of
+// the request once the job is done.
+func (c *JobClient) StartHistoricalRetrieval(ctx context.Context, req *core.GetHistoricalFeaturesRequest) (*Job, error) {
+	resp, err := c.cli.GetHistoricalFeatures(ctx, req)
+	if err != nil {
+		return nil, coreError("GetHistoricalFeatures", err)
+	}
+	job := c.newJob(resp.GetId(), core.JobType_RETRIEVAL_JOB)
+	job.proto.Meta = &core.Job_Retrieval{Retrieval: &core.Job_RetrievalJobMeta{OutputLocation: resp.GetOutputFileUri()}}
+	return job, nil
+}
+
+// GetJob returns a handle of the job with the given id.
+func (c *JobClient) GetJob(ctx context.Context, id string) (*Job, error) {
+	resp, err := c.cli.GetJob(ctx, &core.GetJobRequest{JobId: id})
+	if err != nil {
+		return nil, coreError("GetJob", err)
+	}
+	return &Job{client: c, proto: resp.GetJob()}, nil
+}
+
+// ListJobs returns handles of the active jobs, and of terminated jobs if includeTerminated is set.
+func (c *JobClient) ListJobs(ctx context.Context, includeTerminated bool) ([]*Job, error) {
+	resp, err := c.cli.ListJobs(ctx, &core.ListJobsRequest{IncludeTerminated: includeTerminated})
+	if err != nil {
+		return nil, coreError("ListJobs", err)
+	}
+	jobs := make([]*Job, len(resp.GetJobs()))
+	for i, job := range resp.GetJobs() {
+		jobs[i] = &Job{client: c, proto: job}
+	}
+	return jobs, nil
+}
+
+// Returns a handle of a job that was just started.
+func (c *JobClient) newJob(id string, jobType core.JobType) *Job {
+	return &Job{client: c, proto: &core.Job{Id: id, Type: jobType, Status: core.JobStatus_JOB_STATUS_PENDING}}
+}
+
+// StatusCallback is called when the status of a job changes, with the status it changed from.
+type StatusCallback func(job *Job, previous core.JobStatus)
+
+// Job is a handle of a job run by the Feast job service, holding its last known state.
+// A Job is safe for concurrent use.
+type Job struct {
+	client    *JobClient
+	mu        sync.Mutex
+	proto     *core.Job
+	callbacks []StatusCallback
+	// Project and name of the feature table ingested by jobs started by the client, as not reported by the job
+	// service.
+	project string
+	table   string
+}
+
+// ID returns the id of the job.
+func (j *Job) ID() string {
+	return j.Proto().GetId()
+}
+
+// Proto returns the last known state of the job.
+func (j *Job) Proto() *core.Job {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.proto
+}
+
+// Status returns the last known status of the job.
+func (j *Job) Status() core.JobStatus {
+	return j.Proto().GetStatus()
+}
+
+// Done reports whether the job was last known to have terminated, successfully or not.
+func (j *Job) Done() bool {
+	status := j.Status()
+	return status == core.JobStatus_JOB_STATUS_DONE || status == core.JobStatus_JOB_STATUS_ERROR
+}
+
+// OutputLocation returns the location the output of a retrieval job is written to.
+func (j *Job) OutputLocation() string {
+	return j.Proto().GetRetrieval().GetOutputLocation()
+}
+
+// OnStatusChange registers a callback called by Refresh and Wait when they observe a change of the job's status.
+func (j *Job) OnStatusChange(callback StatusCallback) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.callbacks = append(j.callbacks, callback)
+}
+
+// Refresh fetches the state of the job from the job service, calling status callbacks if its status changed.
+func (j *Job) Refresh(ctx context.Context) error {
+	resp, err := j.client.cli.GetJob(ctx, &core.GetJobRequest{JobId: j.ID()})
+	if err != nil {
+		return coreError("GetJob", err)
+	}
+
+	j.mu.Lock()
+	previous := j.proto
+	updated := resp.GetJob()
+	// The job service may not report the output location of retrieval jobs, which is known when they are started.
+	if updated.GetMeta() == nil {
+		updated.Meta = previous.GetMeta()
+	}
+	j.proto = updated
+	callbacks := j.callbacks
+	j.mu.Unlock()
+
+	if previous.GetStatus() != updated.GetStatus() {
+		for _, callback := range callbacks {
+			callback(j, previous.GetStatus())
+		}
+	}
+	return nil
+}
+
+// Wait polls the job until it terminates, with the interval between polls doubling up to the maximum poll
+// interval of the client. Returns a *JobError if the job failed, or the context's error if it is done first.
+// Polls failing with ErrUnavailable are retried.
+func (j *Job) Wait(ctx context.Context) error {
+	interval := j.client.initialPollInterval
+	var pollErr error
+	for {
+		if j.Done() {
+			break
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		if err := j.Refresh(ctx); err != nil {
+			if !errors.Is(err, ErrUnavailable) {
+				return err
+			}
+			pollErr = err
+		}
+		if interval *= 2; interval > j.client.maxPollInterval {
+			interval = j.client.maxPollInterval
+		}
+	}
+
+	if job := j.Proto(); job.GetStatus() == core.JobStatus_JOB_STATUS_ERROR {
+		return &JobError{
+			JobID:          job.GetId(),
+			Type:           job.GetType(),
+			Status:         job.GetStatus(),
+			Project:        j.project,
+			FeatureTable:   j.table,
+			OutputLocation: job.GetRetrieval().GetOutputLocation(),
+			PollError:      pollErr,
+		}
+	}
+	return nil
+}
+
+// Cancel cancels the job. Waiting for a cancelled job returns a *JobError once the job service reports it stopped.
+func (j *Job) Cancel(ctx context.Context) error {
+	_, err := j.client.cli.CancelJob(ctx, &core.CancelJobRequest{JobId: j.ID()})
+	return coreError("CancelJob", err)
+}
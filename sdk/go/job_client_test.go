@@ -0,0 +1,151 @@
+package feast
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeJobService is a JobServiceClient reporting a scripted sequence of job states, one per GetJob call.
+type fakeJobService struct {
+	core.JobServiceClient
+	states []*core.Job
+	errs   []error
+	// Output location of retrieval jobs whose request does not set one.
+	outputLocation string
+	polls          int
+	cancelled      []string
+}
+
+func (s *fakeJobService) GetHistoricalFeatures(ctx context.Context, in *core.GetHistoricalFeaturesRequest, opts ...grpc.CallOption) (*core.GetHistoricalFeaturesResponse, error) {
+	if in.OutputLocation == "" {
+		return &core.GetHistoricalFeaturesResponse{Id: "job-1", OutputFileUri: s.outputLocation}, nil
+	}
+	return &core.GetHistoricalFeaturesResponse{Id: "job-1", OutputFileUri: in.OutputLocation}, nil
+}
+
+func (s *fakeJobService) StartOfflineToOnlineIngestionJob(ctx context.Context, in *core.StartOfflineToOnlineIngestionJobRequest, opts ...grpc.CallOption) (*core.StartOfflineToOnlineIngestionJobResponse, error) {
+	return &core.StartOfflineToOnlineIngestionJobResponse{Id: "job-1"}, nil
+}
+
+func (s *fakeJobService) GetJob(ctx context.Context, in *core.GetJobRequest, opts ...grpc.CallOption) (*core.GetJobResponse, error) {
+	i := s.polls
+	if i >= len(s.states) {
+		i = len(s.states) - 1
+	}
+	s.polls++
+	if i < len(s.errs) && s.errs[i] != nil {
+		return nil, s.errs[i]
+	}
+	return &core.GetJobResponse{Job: proto.Clone(s.states[i]).(*core.Job)}, nil
+}
+
+func (s *fakeJobService) CancelJob(ctx context.Context, in *core.CancelJobRequest, opts ...grpc.CallOption) (*core.CancelJobResponse, error) {
+	s.cancelled = append(s.cancelled, in.JobId)
+	return &core.CancelJobResponse{}, nil
+}
+
+func jobState(status core.JobStatus) *core.Job {
+	return &core.Job{Id: "job-1", Type: core.JobType_BATCH_INGESTION_JOB, Status: status}
+}
+
+func TestJobWait(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	tt := []struct {
+		name         string
+		states       []*core.Job
+		errs         []error
+		wantErr      string
+		wantStatuses []string
+	}{
+		{
+			name:         "Done",
+			states:       []*core.Job{jobState(core.JobStatus_JOB_STATUS_PENDING), jobState(core.JobStatus_JOB_STATUS_RUNNING), jobState(core.JobStatus_JOB_STATUS_DONE)},
+			wantStatuses: []string{"JOB_STATUS_PENDING -> JOB_STATUS_RUNNING", "JOB_STATUS_RUNNING -> JOB_STATUS_DONE"},
+		},
+		{
+			name:         "Failed",
+			states:       []*core.Job{jobState(core.JobStatus_JOB_STATUS_RUNNING), jobState(core.JobStatus_JOB_STATUS_ERROR)},
+			wantErr:      "feast job job-1 (BATCH_INGESTION_JOB) of feature table driver_project/driver_stats failed with status JOB_STATUS_ERROR",
+			wantStatuses: []string{"JOB_STATUS_PENDING -> JOB_STATUS_RUNNING", "JOB_STATUS_RUNNING -> JOB_STATUS_ERROR"},
+		},
+		{
+			name:         "Failed after unavailable polls",
+			states:       []*core.Job{nil, jobState(core.JobStatus_JOB_STATUS_ERROR)},
+			errs:         []error{unavailable},
+			wantErr:      "feast job job-1 (BATCH_INGESTION_JOB) of feature table driver_project/driver_stats failed with status JOB_STATUS_ERROR, last poll error: feast core GetJob failed: Unavailable: connection refused",
+			wantStatuses: []string{"JOB_STATUS_PENDING -> JOB_STATUS_ERROR"},
+		},
+		{
+			name:         "Unavailable polls are retried",
+			states:       []*core.Job{nil, jobState(core.JobStatus_JOB_STATUS_DONE)},
+			errs:         []error{unavailable},
+			wantStatuses: []string{"JOB_STATUS_PENDING -> JOB_STATUS_DONE"},
+		},
+		{
+			name:    "Poll error",
+			states:  []*core.Job{nil},
+			errs:    []error{status.Error(codes.PermissionDenied, "not allowed")},
+			wantErr: "feast core GetJob failed: PermissionDenied: not allowed",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &fakeJobService{states: tc.states, errs: tc.errs}
+			client := newJobClient(service, nil).WithPollInterval(time.Millisecond, 2*time.Millisecond)
+			job, err := client.StartOfflineToOnlineIngestion(context.Background(), "driver_project", "driver_stats", time.Time{}, time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			var statuses []string
+			job.OnStatusChange(func(job *Job, previous core.JobStatus) {
+				statuses = append(statuses, previous.String()+" -> "+job.Status().String())
+			})
+
+			err = job.Wait(context.Background())
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != tc.wantErr {
+				t.Errorf("Expected error %q, got %q", tc.wantErr, gotErr)
+			}
+			if diff := cmp.Diff(tc.wantStatuses, statuses); diff != "" {
+				t.Errorf("Unexpected status changes (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJobWaitContext(t *testing.T) {
+	service := &fakeJobService{states: []*core.Job{jobState(core.JobStatus_JOB_STATUS_RUNNING)}}
+	client := newJobClient(service, nil).WithPollInterval(time.Millisecond, time.Millisecond)
+	job, err := client.StartHistoricalRetrieval(context.Background(), &core.GetHistoricalFeaturesRequest{OutputLocation: "file:///tmp/output"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := job.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	// The output location known when starting the job is kept when the job service does not report it.
+	if job.OutputLocation() != "file:///tmp/output" {
+		t.Errorf("Expected output location file:///tmp/output, got %s", job.OutputLocation())
+	}
+
+	if err := job.Cancel(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"job-1"}, service.cancelled); diff != "" {
+		t.Errorf("Unexpected cancelled jobs (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,106 @@
+package lineage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Labels of node kinds used in DOT and Mermaid exports.
+var kindLabels = map[NodeKind]string{
+	KindFeatureService:        "feature service",
+	KindFeatureViewProjection: "projection",
+	KindFeatureView:           "feature view",
+	KindOnDemandFeatureView:   "on demand feature view",
+	KindRequestFeatureView:    "request feature view",
+	KindDataSource:            "data source",
+	KindEntity:                "entity",
+}
+
+// Shapes of node kinds used in DOT exports.
+var dotShapes = map[NodeKind]string{
+	KindFeatureService:        "box",
+	KindFeatureViewProjection: "note",
+	KindFeatureView:           "ellipse",
+	KindOnDemandFeatureView:   "ellipse",
+	KindRequestFeatureView:    "ellipse",
+	KindDataSource:            "cylinder",
+	KindEntity:                "diamond",
+}
+
+// Returns the label of the node, marking undefined nodes.
+func nodeLabel(node Node) string {
+	label := kindLabels[node.Kind] + "\n" + node.Name
+	if node.Project != "" && node.Kind != KindFeatureViewProjection {
+		label = kindLabels[node.Kind] + "\n" + node.Project + "/" + node.Name
+	}
+	if node.Undefined {
+		label += "\n(undefined)"
+	}
+	return label
+}
+
+// WriteDOT writes the graph to w in the Graphviz DOT language.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph lineage {\n  rankdir=LR;\n")
+	for _, node := range g.Nodes() {
+		style := ""
+		if node.Undefined {
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(&b, "  %q [label=%q, shape=%s%s];\n", node.ID, nodeLabel(node), dotShapes[node.Kind], style)
+	}
+	for _, edge := range g.Edges() {
+		fmt.Fprintf(&b, "  %q -> %q;\n", edge.From, edge.To)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMermaid writes the graph to w as a Mermaid flowchart.
+func (g *Graph) WriteMermaid(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	// Mermaid ids cannot contain most punctuation, so nodes are numbered in id order.
+	ids := make(map[string]string)
+	for i, node := range g.Nodes() {
+		ids[node.ID] = fmt.Sprintf("n%d", i)
+		label := strings.Replace(nodeLabel(node), "\n", "<br/>", -1)
+		label = strings.Replace(label, `"`, "#quot;", -1)
+		start, end := "[", "]"
+		switch node.Kind {
+		case KindDataSource:
+			start, end = "[(", ")]"
+		case KindEntity:
+			start, end = "{", "}"
+		case KindFeatureService:
+			start, end = "[[", "]]"
+		}
+		fmt.Fprintf(&b, "  %s%s\"%s\"%s\n", ids[node.ID], start, label, end)
+	}
+	for _, edge := range g.Edges() {
+		fmt.Fprintf(&b, "  %s --> %s\n", ids[edge.From], ids[edge.To])
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteJSON writes the nodes and edges of the graph to w as indented JSON.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	graph := struct {
+		Nodes []Node `json:"nodes"`
+		Edges []Edge `json:"edges"`
+	}{Nodes: g.Nodes(), Edges: g.Edges()}
+	if graph.Edges == nil {
+		graph.Edges = []Edge{}
+	}
+	graphJSON, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", graphJSON)
+	return err
+}
@@ -0,0 +1,275 @@
+// Package lineage builds lineage graphs of the objects of a Feast registry, relating feature services
+// to the feature views, data sources and entities they depend on.
+package lineage
+
+import (
+	"sort"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+// NodeKind is the kind of object a lineage graph node represents.
+type NodeKind string
+
+const (
+	KindFeatureService        NodeKind = "feature_service"
+	KindFeatureViewProjection NodeKind = "feature_view_projection"
+	KindFeatureView           NodeKind = "feature_view"
+	KindOnDemandFeatureView   NodeKind = "on_demand_feature_view"
+	KindRequestFeatureView    NodeKind = "request_feature_view"
+	KindDataSource            NodeKind = "data_source"
+	KindEntity                NodeKind = "entity"
+)
+
+// Node is an object in a lineage graph.
+type Node struct {
+	// ID uniquely identifies the node, see NodeID.
+	ID      string   `json:"id"`
+	Kind    NodeKind `json:"kind"`
+	Project string   `json:"project,omitempty"`
+	Name    string   `json:"name"`
+	// Undefined is set for nodes referenced by other objects but not defined in the registry.
+	Undefined bool `json:"undefined,omitempty"`
+}
+
+// Edge relates an object to an object it depends on.
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// NodeID returns the id of the node of the given kind, project and name, ie. "feature_view:driver_project/driver_stats".
+// Data sources are scoped to the project of the objects reading them, ie. "data_source:driver_project/s3://bucket/driver_stats.parquet",
+// as the same data may be read by unrelated projects.
+// Projections are named by their feature service and the name the projected feature view is served as,
+// ie. "feature_view_projection:driver_project/driver_service/driver_stats".
+func NodeID(kind NodeKind, project string, name string) string {
+	if project == "" {
+		return string(kind) + ":" + name
+	}
+	return string(kind) + ":" + project + "/" + name
+}
+
+// Graph is a lineage graph, with edges pointing from objects to the objects they depend on:
+// feature services to their projections, projections to feature views and on demand feature views,
+// on demand feature views to their input feature views and request sources, and feature views to their
+// data sources and entities.
+type Graph struct {
+	nodes        map[string]Node
+	dependencies map[string]map[string]bool
+	consumers    map[string]map[string]bool
+}
+
+func newGraph() *Graph {
+	return &Graph{
+		nodes:        make(map[string]Node),
+		dependencies: make(map[string]map[string]bool),
+		consumers:    make(map[string]map[string]bool),
+	}
+}
+
+// Build builds the lineage graph of the objects in the given registry.
+func Build(registry *core.Registry) *Graph {
+	g := newGraph()
+	for _, entity := range registry.GetEntities() {
+		g.addNode(Node{Kind: KindEntity, Project: entity.GetSpec().GetProject(), Name: entity.GetSpec().GetName()})
+	}
+
+	for _, featureView := range registry.GetFeatureViews() {
+		spec := featureView.GetSpec()
+		id := g.addNode(Node{Kind: KindFeatureView, Project: spec.GetProject(), Name: spec.GetName()})
+		for _, source := range []*core.DataSource{spec.GetBatchSource(), spec.GetStreamSource()} {
+			if source != nil {
+				g.addEdge(id, g.addDataSource(source, spec.GetProject(), spec.GetName()))
+			}
+		}
+		for _, entity := range spec.GetEntities() {
+			g.addEdge(id, g.reference(KindEntity, spec.GetProject(), entity))
+		}
+	}
+
+	for _, requestFeatureView := range registry.GetRequestFeatureViews() {
+		spec := requestFeatureView.GetSpec()
+		id := g.addNode(Node{Kind: KindRequestFeatureView, Project: spec.GetProject(), Name: spec.GetName()})
+		if source := spec.GetRequestDataSource(); source != nil {
+			g.addEdge(id, g.addDataSource(source, spec.GetProject(), spec.GetName()))
+		}
+	}
+
+	for _, odfv := range registry.GetOnDemandFeatureViews() {
+		spec := odfv.GetSpec()
+		id := g.addNode(Node{Kind: KindOnDemandFeatureView, Project: spec.GetProject(), Name: spec.GetName()})
+		wrapper := feast.NewOnDemandFeatureViewFromProto(odfv)
+		for _, input := range wrapper.FeatureViewInputs() {
+			g.addEdge(id, g.reference(KindFeatureView, spec.GetProject(), input))
+		}
+		for _, source := range wrapper.RequestDataSources() {
+			g.addEdge(id, g.addDataSource(source, spec.GetProject(), spec.GetName()))
+		}
+	}
+
+	for _, featureService := range registry.GetFeatureServices() {
+		spec := featureService.GetSpec()
+		id := g.addNode(Node{Kind: KindFeatureService, Project: spec.GetProject(), Name: spec.GetName()})
+		for _, projection := range feast.NewFeatureServiceFromProto(featureService).Projections() {
+			projectionID := g.addNode(Node{
+				Kind:    KindFeatureViewProjection,
+				Project: spec.GetProject(),
+				Name:    spec.GetName() + "/" + projection.NameToUse(),
+			})
+			g.addEdge(id, projectionID)
+			g.addEdge(projectionID, g.projected(spec.GetProject(), projection.Name()))
+		}
+	}
+	return g
+}
+
+// Adds the node to the graph, returning its id. Replaces undefined nodes of the same id.
+func (g *Graph) addNode(node Node) string {
+	node.ID = NodeID(node.Kind, node.Project, node.Name)
+	g.nodes[node.ID] = node
+	return node.ID
+}
+
+// Returns the id of the referenced node, adding it as an undefined node if it does not exist yet.
+func (g *Graph) reference(kind NodeKind, project string, name string) string {
+	id := NodeID(kind, project, name)
+	if _, ok := g.nodes[id]; !ok {
+		g.nodes[id] = Node{ID: id, Kind: kind, Project: project, Name: name, Undefined: true}
+	}
+	return id
+}
+
+// Returns the id of the feature view, on demand feature view or request feature view of the given name
+// projected by a feature service, adding an undefined feature view if none exists.
+func (g *Graph) projected(project string, name string) string {
+	for _, kind := range []NodeKind{KindFeatureView, KindOnDemandFeatureView, KindRequestFeatureView} {
+		if id := NodeID(kind, project, name); g.nodes[id].ID != "" {
+			return id
+		}
+	}
+	return g.reference(KindFeatureView, project, name)
+}
+
+// Adds a node for the data source read by an object of the given project, named after the data it reads,
+// or the object reading it if unnamed.
+func (g *Graph) addDataSource(source *core.DataSource, project string, owner string) string {
+	name := feast.DataSourceName(source)
+	if name == "" {
+		name = owner + "/" + source.GetType().String()
+	}
+	return g.addNode(Node{Kind: KindDataSource, Project: project, Name: name})
+}
+
+func (g *Graph) addEdge(from string, to string) {
+	if g.dependencies[from] == nil {
+		g.dependencies[from] = make(map[string]bool)
+	}
+	if g.consumers[to] == nil {
+		g.consumers[to] = make(map[string]bool)
+	}
+	g.dependencies[from][to] = true
+	g.consumers[to][from] = true
+}
+
+// Node returns the node with the given id.
+func (g *Graph) Node(id string) (Node, bool) {
+	node, ok := g.nodes[id]
+	return node, ok
+}
+
+// Nodes returns the nodes of the graph, sorted by id.
+func (g *Graph) Nodes() []Node {
+	nodes := make([]Node, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		nodes = append(nodes, node)
+	}
+	sortNodes(nodes)
+	return nodes
+}
+
+// Edges returns the edges of the graph, sorted by the ids of their nodes.
+func (g *Graph) Edges() []Edge {
+	var edges []Edge
+	for from, tos := range g.dependencies {
+		for to := range tos {
+			edges = append(edges, Edge{From: from, To: to})
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+	return edges
+}
+
+// Dependencies returns the nodes the node with the given id depends on directly or transitively, sorted by id.
+// ie. the feature views, data sources and entities a feature service depends on.
+func (g *Graph) Dependencies(id string) []Node {
+	return g.reachable(id, g.dependencies)
+}
+
+// Consumers returns the nodes depending on the node with the given id directly or transitively, sorted by id.
+// ie. the feature views and feature services consuming a data source.
+func (g *Graph) Consumers(id string) []Node {
+	return g.reachable(id, g.consumers)
+}
+
+// Returns the nodes reachable from the node with the given id following the given edges.
+func (g *Graph) reachable(id string, edges map[string]map[string]bool) []Node {
+	seen := make(map[string]bool)
+	var nodes []Node
+	g.walk(id, edges, func(from string, to string) {
+		if !seen[to] && to != id {
+			seen[to] = true
+			nodes = append(nodes, g.nodes[to])
+		}
+	})
+	sortNodes(nodes)
+	return nodes
+}
+
+// Subgraph returns the subgraph of the node with the given id, its dependencies and its consumers.
+// Returns an empty graph if the node does not exist.
+func (g *Graph) Subgraph(id string) *Graph {
+	subgraph := newGraph()
+	node, ok := g.nodes[id]
+	if !ok {
+		return subgraph
+	}
+	subgraph.nodes[id] = node
+	// Only keep edges along the lineage of the node, not to siblings sharing a dependency.
+	g.walk(id, g.dependencies, func(from string, to string) {
+		subgraph.nodes[to] = g.nodes[to]
+		subgraph.addEdge(from, to)
+	})
+	g.walk(id, g.consumers, func(to string, from string) {
+		subgraph.nodes[from] = g.nodes[from]
+		subgraph.addEdge(from, to)
+	})
+	return subgraph
+}
+
+// Walks the edges reachable from the node with the given id, calling visit once for each edge.
+func (g *Graph) walk(id string, edges map[string]map[string]bool, visit func(from string, to string)) {
+	visited := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for next := range edges[current] {
+			visit(current, next)
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+}
+
+func sortNodes(nodes []Node) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+}
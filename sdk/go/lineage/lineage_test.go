@@ -0,0 +1,218 @@
+package lineage
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Returns a registry where a feature service depends on a feature view and an on demand feature view
+// built on top of it, with a second feature view reading the same file source, and a feature view of
+// another project reading it too.
+func testRegistry() *core.Registry {
+	fileSource := &core.DataSource{
+		Type:    core.DataSource_BATCH_FILE,
+		Options: &core.DataSource_FileOptions_{FileOptions: &core.DataSource_FileOptions{FileUrl: "s3://bucket/drivers.parquet"}},
+	}
+	return &core.Registry{
+		Entities: []*core.Entity{{Spec: &core.EntitySpecV2{Name: "driver", Project: "p"}}},
+		FeatureViews: []*core.FeatureView{
+			{Spec: &core.FeatureViewSpec{Name: "driver_stats", Project: "p", Entities: []string{"driver"}, BatchSource: fileSource}},
+			{Spec: &core.FeatureViewSpec{
+				Name: "driver_trips", Project: "p", Entities: []string{"driver"}, BatchSource: fileSource,
+				StreamSource: &core.DataSource{
+					Type:    core.DataSource_STREAM_KAFKA,
+					Options: &core.DataSource_KafkaOptions_{KafkaOptions: &core.DataSource_KafkaOptions{Topic: "trips"}},
+				},
+			}},
+			{Spec: &core.FeatureViewSpec{Name: "driver_stats", Project: "q", BatchSource: fileSource}},
+		},
+		OnDemandFeatureViews: []*core.OnDemandFeatureView{{Spec: &core.OnDemandFeatureViewSpec{
+			Name: "driver_adjusted", Project: "p",
+			Inputs: map[string]*core.OnDemandInput{
+				"driver_stats": {Input: &core.OnDemandInput_FeatureViewProjection{FeatureViewProjection: &core.FeatureViewProjection{FeatureViewName: "driver_stats"}}},
+				"request": {Input: &core.OnDemandInput_RequestDataSource{RequestDataSource: &core.DataSource{
+					Type:    core.DataSource_REQUEST_SOURCE,
+					Options: &core.DataSource_RequestDataOptions_{RequestDataOptions: &core.DataSource_RequestDataOptions{Name: "driver_request"}},
+				}}},
+			},
+		}}},
+		FeatureServices: []*core.FeatureService{
+			{Spec: &core.FeatureServiceSpec{Name: "driver_service", Project: "p", Features: []*core.FeatureViewProjection{
+				{FeatureViewName: "driver_adjusted"},
+				{FeatureViewName: "driver_stats", FeatureViewNameAlias: "stats"},
+			}}},
+			{Spec: &core.FeatureServiceSpec{Name: "vehicle_service", Project: "p", Features: []*core.FeatureViewProjection{
+				{FeatureViewName: "vehicle_stats"},
+			}}},
+		},
+	}
+}
+
+// Returns the ids of the given nodes.
+func nodeIDs(nodes []Node) []string {
+	ids := make([]string, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+	}
+	return ids
+}
+
+func TestLineageQueries(t *testing.T) {
+	g := Build(testRegistry())
+
+	tt := []struct {
+		name  string
+		query func(id string) []Node
+		id    string
+		want  []string
+	}{
+		{
+			name:  "Service dependencies",
+			query: g.Dependencies,
+			id:    NodeID(KindFeatureService, "p", "driver_service"),
+			want: []string{
+				"data_source:p/driver_request",
+				"data_source:p/s3://bucket/drivers.parquet",
+				"entity:p/driver",
+				"feature_view:p/driver_stats",
+				"feature_view_projection:p/driver_service/driver_adjusted",
+				"feature_view_projection:p/driver_service/stats",
+				"on_demand_feature_view:p/driver_adjusted",
+			},
+		},
+		{
+			name:  "Source consumers",
+			query: g.Consumers,
+			id:    NodeID(KindDataSource, "p", "s3://bucket/drivers.parquet"),
+			want: []string{
+				"feature_service:p/driver_service",
+				"feature_view:p/driver_stats",
+				"feature_view:p/driver_trips",
+				"feature_view_projection:p/driver_service/driver_adjusted",
+				"feature_view_projection:p/driver_service/stats",
+				"on_demand_feature_view:p/driver_adjusted",
+			},
+		},
+		{
+			name:  "Source consumers of another project",
+			query: g.Consumers,
+			id:    NodeID(KindDataSource, "q", "s3://bucket/drivers.parquet"),
+			want:  []string{"feature_view:q/driver_stats"},
+		},
+		{
+			name:  "Stream source consumers",
+			query: g.Consumers,
+			id:    NodeID(KindDataSource, "p", "trips"),
+			want:  []string{"feature_view:p/driver_trips"},
+		},
+		{
+			name:  "Undefined feature view",
+			query: g.Dependencies,
+			id:    NodeID(KindFeatureService, "p", "vehicle_service"),
+			want:  []string{"feature_view:p/vehicle_stats", "feature_view_projection:p/vehicle_service/vehicle_stats"},
+		},
+		{
+			name:  "Unknown node",
+			query: g.Consumers,
+			id:    "data_source:unknown",
+			want:  []string{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, nodeIDs(tc.query(tc.id))); diff != "" {
+				t.Errorf("Unexpected nodes (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if node, _ := g.Node("feature_view:p/vehicle_stats"); !node.Undefined {
+		t.Error("Expected referenced but undefined feature view to be marked undefined")
+	}
+}
+
+func TestLineageExport(t *testing.T) {
+	g := Build(testRegistry()).Subgraph(NodeID(KindDataSource, "p", "trips"))
+
+	var dot bytes.Buffer
+	if err := g.WriteDOT(&dot); err != nil {
+		t.Fatal(err)
+	}
+	wantDOT := strings.Join([]string{
+		"digraph lineage {",
+		"  rankdir=LR;",
+		`  "data_source:p/trips" [label="data source\np/trips", shape=cylinder];`,
+		`  "feature_view:p/driver_trips" [label="feature view\np/driver_trips", shape=ellipse];`,
+		`  "feature_view:p/driver_trips" -> "data_source:p/trips";`,
+		"}",
+		"",
+	}, "\n")
+	if diff := cmp.Diff(wantDOT, dot.String()); diff != "" {
+		t.Errorf("Unexpected DOT output (-want +got):\n%s", diff)
+	}
+
+	var mermaid bytes.Buffer
+	if err := g.WriteMermaid(&mermaid); err != nil {
+		t.Fatal(err)
+	}
+	wantMermaid := strings.Join([]string{
+		"flowchart LR",
+		`  n0[("data source<br/>p/trips")]`,
+		`  n1["feature view<br/>p/driver_trips"]`,
+		"  n1 --> n0",
+		"",
+	}, "\n")
+	if diff := cmp.Diff(wantMermaid, mermaid.String()); diff != "" {
+		t.Errorf("Unexpected Mermaid output (-want +got):\n%s", diff)
+	}
+
+	var jsonOutput bytes.Buffer
+	if err := Build(testRegistry()).WriteJSON(&jsonOutput); err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Nodes []Node
+		Edges []Edge
+	}
+	if err := json.Unmarshal(jsonOutput.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	full := Build(testRegistry())
+	if diff := cmp.Diff(full.Nodes(), decoded.Nodes); diff != "" {
+		t.Errorf("Unexpected JSON nodes (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(full.Edges(), decoded.Edges); diff != "" {
+		t.Errorf("Unexpected JSON edges (-want +got):\n%s", diff)
+	}
+}
+
+func TestSubgraph(t *testing.T) {
+	g := Build(testRegistry()).Subgraph(NodeID(KindFeatureView, "p", "driver_stats"))
+	// driver_trips shares the file source and entity, but is not part of driver_stats' lineage.
+	want := []string{
+		"data_source:p/s3://bucket/drivers.parquet",
+		"entity:p/driver",
+		"feature_service:p/driver_service",
+		"feature_view:p/driver_stats",
+		"feature_view_projection:p/driver_service/driver_adjusted",
+		"feature_view_projection:p/driver_service/stats",
+		"on_demand_feature_view:p/driver_adjusted",
+	}
+	if diff := cmp.Diff(want, nodeIDs(g.Nodes())); diff != "" {
+		t.Errorf("Unexpected subgraph nodes (-want +got):\n%s", diff)
+	}
+	for _, edge := range g.Edges() {
+		if _, ok := g.Node(edge.From); !ok {
+			t.Errorf("Edge from node %s outside subgraph", edge.From)
+		}
+	}
+	if len(Build(testRegistry()).Subgraph("feature_view:p/unknown").Nodes()) != 0 {
+		t.Error("Expected empty subgraph for unknown node")
+	}
+}
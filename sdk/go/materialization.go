@@ -0,0 +1,150 @@
+package feast
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+// MaterializationStatus describes how well the materialized intervals of a feature view cover a time window.
+type MaterializationStatus struct {
+	Project     string
+	FeatureView string
+	// Window the intervals are checked against.
+	Window MaterializationInterval
+	// Materialized holds the merged materialized intervals, sorted by start time.
+	Materialized []MaterializationInterval
+	// Gaps holds the ranges of the window that have not been materialized, sorted by start time.
+	Gaps []MaterializationInterval
+	// LastMaterialized is the end time of the latest materialized interval, zero if never materialized.
+	LastMaterialized time.Time
+	// Stale reports whether the feature view has no online values at the end of the window: it was never
+	// materialized, or its last materialized end time is older than its TTL, meaning all its online values expired.
+	Stale bool
+}
+
+// HasGaps reports whether parts of the window have not been materialized.
+func (s *MaterializationStatus) HasGaps() bool {
+	return len(s.Gaps) > 0
+}
+
+// AnalyzeMaterialization merges the materialized intervals of the feature view and reports the ranges of the window
+// from start to end that have not been materialized. A zero start defaults to end minus the TTL of the feature view,
+// the oldest event time whose values can still be served online, or to the start of the first materialized interval
+// if the feature view has no TTL. Feature views that were never materialized are stale, whatever their TTL.
+func AnalyzeMaterialization(fv *FeatureView, start time.Time, end time.Time) *MaterializationStatus {
+	status := &MaterializationStatus{
+		Project:      fv.Project(),
+		FeatureView:  fv.Name(),
+		Materialized: mergeIntervals(fv.MaterializationIntervals()),
+	}
+	if n := len(status.Materialized); n > 0 {
+		status.LastMaterialized = status.Materialized[n-1].End
+	}
+	ttl := fv.TTL()
+	if start.IsZero() {
+		if ttl > 0 {
+			start = end.Add(-ttl)
+		} else if len(status.Materialized) > 0 {
+			start = status.Materialized[0].Start
+		} else {
+			start = end
+		}
+	}
+	status.Window = MaterializationInterval{Start: start, End: end}
+	status.Stale = status.LastMaterialized.IsZero() || (ttl > 0 && end.Sub(status.LastMaterialized) > ttl)
+
+	covered := start
+	for _, interval := range status.Materialized {
+		if !interval.End.After(covered) {
+			continue
+		}
+		if !interval.Start.Before(end) {
+			break
+		}
+		if interval.Start.After(covered) {
+			status.Gaps = append(status.Gaps, MaterializationInterval{Start: covered, End: interval.Start})
+		}
+		covered = interval.End
+	}
+	if covered.Before(end) {
+		status.Gaps = append(status.Gaps, MaterializationInterval{Start: covered, End: end})
+	}
+	return status
+}
+
+// AnalyzeRegistryMaterialization analyzes the materialization of the online feature views of the given project,
+// or of all projects if project is empty, as AnalyzeMaterialization does. Statuses are sorted by project and name.
+func AnalyzeRegistryMaterialization(registry *core.Registry, project string, start time.Time, end time.Time) []*MaterializationStatus {
+	var statuses []*MaterializationStatus
+	for _, featureViewProto := range registry.GetFeatureViews() {
+		fv := NewFeatureViewFromProto(featureViewProto)
+		if (project == "" || fv.Project() == project) && fv.Online() {
+			statuses = append(statuses, AnalyzeMaterialization(fv, start, end))
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].Project != statuses[j].Project {
+			return statuses[i].Project < statuses[j].Project
+		}
+		return statuses[i].FeatureView < statuses[j].FeatureView
+	})
+	return statuses
+}
+
+// WriteMaterializationReport writes a human readable report of the given statuses, listing the gaps and stale
+// feature views.
+func WriteMaterializationReport(w io.Writer, statuses []*MaterializationStatus) error {
+	var lines []string
+	gaps, stale := 0, 0
+	for _, status := range statuses {
+		name := status.Project + "/" + status.FeatureView
+		if status.Stale {
+			stale++
+			last := "never materialized"
+			if !status.LastMaterialized.IsZero() {
+				last = "last materialized up to " + status.LastMaterialized.UTC().Format(time.RFC3339)
+			}
+			lines = append(lines, fmt.Sprintf("! feature view %s is stale: %s", name, last))
+		}
+		for _, gap := range status.Gaps {
+			gaps++
+			lines = append(lines, fmt.Sprintf("- feature view %s is not materialized from %s to %s", name,
+				gap.Start.UTC().Format(time.RFC3339), gap.End.UTC().Format(time.RFC3339)))
+		}
+	}
+	lines = append(lines, fmt.Sprintf("Materialization: %d feature views, %d gaps, %d stale.", len(statuses), gaps, stale))
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the given intervals sorted by start time, with overlapping and adjacent intervals merged.
+// Empty intervals are dropped.
+func mergeIntervals(intervals []MaterializationInterval) []MaterializationInterval {
+	sorted := make([]MaterializationInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		if interval.End.After(interval.Start) {
+			sorted = append(sorted, interval)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	var merged []MaterializationInterval
+	for _, interval := range sorted {
+		if n := len(merged); n > 0 && !interval.Start.After(merged[n-1].End) {
+			if interval.End.After(merged[n-1].End) {
+				merged[n-1].End = interval.End
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return merged
+}
@@ -0,0 +1,141 @@
+package feast
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var materializationDay = time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// Returns the interval between the given hours of materializationDay.
+func hours(start int, end int) MaterializationInterval {
+	return MaterializationInterval{
+		Start: materializationDay.Add(time.Duration(start) * time.Hour),
+		End:   materializationDay.Add(time.Duration(end) * time.Hour),
+	}
+}
+
+// Returns a feature view of driver_project materialized for the given intervals.
+func materializedFeatureView(name string, ttl time.Duration, intervals ...MaterializationInterval) *FeatureView {
+	fv := NewFeatureView(name, []string{"driver"},
+		FileSource{Path: "driver_stats.parquet", EventTimestampColumn: "event_timestamp"},
+		Feature{Name: "rating", ValueType: types.ValueType_DOUBLE},
+	).WithTTL(ttl)
+	fv.proto.Spec.Project = "driver_project"
+	fv.proto.Meta = &core.FeatureViewMeta{}
+	for _, interval := range intervals {
+		fv.proto.Meta.MaterializationIntervals = append(fv.proto.Meta.MaterializationIntervals, &core.MaterializationInterval{
+			StartTime: timestamppb.New(interval.Start),
+			EndTime:   timestamppb.New(interval.End),
+		})
+	}
+	return fv
+}
+
+func TestAnalyzeMaterialization(t *testing.T) {
+	tt := []struct {
+		name             string
+		fv               *FeatureView
+		start            time.Time
+		end              time.Time
+		wantMaterialized []MaterializationInterval
+		wantGaps         []MaterializationInterval
+		wantStale        bool
+	}{
+		{
+			name:             "Overlapping and adjacent intervals are merged",
+			fv:               materializedFeatureView("driver_stats", 0, hours(4, 8), hours(0, 2), hours(1, 3), hours(3, 4)),
+			start:            materializationDay,
+			end:              materializationDay.Add(8 * time.Hour),
+			wantMaterialized: []MaterializationInterval{hours(0, 8)},
+		},
+		{
+			name:             "Gaps within the window",
+			fv:               materializedFeatureView("driver_stats", 0, hours(2, 4), hours(6, 8), hours(10, 12)),
+			start:            materializationDay.Add(3 * time.Hour),
+			end:              materializationDay.Add(11 * time.Hour),
+			wantMaterialized: []MaterializationInterval{hours(2, 4), hours(6, 8), hours(10, 12)},
+			wantGaps:         []MaterializationInterval{hours(4, 6), hours(8, 10)},
+		},
+		{
+			name:             "Window defaults to the TTL",
+			fv:               materializedFeatureView("driver_stats", 6*time.Hour, hours(0, 4), hours(5, 7)),
+			end:              materializationDay.Add(10 * time.Hour),
+			wantMaterialized: []MaterializationInterval{hours(0, 4), hours(5, 7)},
+			wantGaps:         []MaterializationInterval{hours(4, 5), hours(7, 10)},
+		},
+		{
+			name:             "Stale",
+			fv:               materializedFeatureView("driver_stats", 2*time.Hour, hours(0, 4)),
+			end:              materializationDay.Add(7 * time.Hour),
+			wantMaterialized: []MaterializationInterval{hours(0, 4)},
+			wantGaps:         []MaterializationInterval{hours(5, 7)},
+			wantStale:        true,
+		},
+		{
+			name:      "Never materialized",
+			fv:        materializedFeatureView("driver_stats", 2*time.Hour),
+			end:       materializationDay.Add(7 * time.Hour),
+			wantGaps:  []MaterializationInterval{hours(5, 7)},
+			wantStale: true,
+		},
+		{
+			name:      "Never materialized without TTL",
+			fv:        materializedFeatureView("driver_stats", 0),
+			end:       materializationDay.Add(7 * time.Hour),
+			wantStale: true,
+		},
+		{
+			name:      "Never materialized without TTL within a window",
+			fv:        materializedFeatureView("driver_stats", 0),
+			start:     materializationDay.Add(2 * time.Hour),
+			end:       materializationDay.Add(7 * time.Hour),
+			wantGaps:  []MaterializationInterval{hours(2, 7)},
+			wantStale: true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			status := AnalyzeMaterialization(tc.fv, tc.start, tc.end)
+			if diff := cmp.Diff(tc.wantMaterialized, status.Materialized); diff != "" {
+				t.Errorf("Unexpected materialized intervals (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantGaps, status.Gaps); diff != "" {
+				t.Errorf("Unexpected gaps (-want +got):\n%s", diff)
+			}
+			if status.Stale != tc.wantStale {
+				t.Errorf("Expected stale %v, got %v", tc.wantStale, status.Stale)
+			}
+		})
+	}
+}
+
+func TestWriteMaterializationReport(t *testing.T) {
+	registry := &core.Registry{FeatureViews: []*core.FeatureView{
+		materializedFeatureView("driver_trips", 2*time.Hour, hours(0, 4)).Proto(),
+		materializedFeatureView("driver_stats", 6*time.Hour, hours(0, 3), hours(4, 8)).Proto(),
+		materializedFeatureView("driver_offline", time.Hour).WithOnline(false).Proto(),
+		materializedFeatureView("driver_profile", 0).Proto(),
+	}}
+	statuses := AnalyzeRegistryMaterialization(registry, "driver_project", time.Time{}, materializationDay.Add(8*time.Hour))
+
+	var buf bytes.Buffer
+	if err := WriteMaterializationReport(&buf, statuses); err != nil {
+		t.Fatal(err)
+	}
+	want := `! feature view driver_project/driver_profile is stale: never materialized
+- feature view driver_project/driver_stats is not materialized from 2021-06-01T03:00:00Z to 2021-06-01T04:00:00Z
+! feature view driver_project/driver_trips is stale: last materialized up to 2021-06-01T04:00:00Z
+- feature view driver_project/driver_trips is not materialized from 2021-06-01T06:00:00Z to 2021-06-01T08:00:00Z
+Materialization: 3 feature views, 2 gaps, 2 stale.
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("Unexpected report (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,203 @@
+package feast
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/golang/protobuf/proto"
+)
+
+// Python class of Kafka sources, used by the Python SDK to deserialize data sources.
+const kafkaSourceClassType = "feast.data_source.KafkaSource"
+
+// MigrationIssue is a part of a legacy definition that could not be mapped to a feature view.
+type MigrationIssue struct {
+	// Kind of the legacy object, "feature table" or "feature set".
+	Kind    string
+	Project string
+	Name    string
+	// Field of the legacy object that could not be mapped, ie. "features[rating].int_domain".
+	Field  string
+	Reason string
+}
+
+func (issue MigrationIssue) String() string {
+	return fmt.Sprintf("%s %s/%s: %s: %s", issue.Kind, issue.Project, issue.Name, issue.Field, issue.Reason)
+}
+
+// Migration holds the feature views and entities converted from legacy FeatureTable and FeatureSet definitions,
+// along with the issues found converting them.
+type Migration struct {
+	FeatureViews []*core.FeatureView
+	// Entities defined by feature sets, which declare their entities inline rather than referencing them.
+	Entities []*core.Entity
+	Issues   []MigrationIssue
+}
+
+// MigrateLegacyDefinitions converts legacy feature tables and feature sets to feature views.
+//
+// Feature tables map to feature views with the same entities, features and sources, max_age becoming the TTL
+// and labels becoming tags. Feature sets additionally define an entity for each of their entities, joined on the
+// entity name, and their Kafka source becomes the stream source of the feature view. Anything that cannot be
+// represented by feature views is reported as an issue: feature set constraints and domains, versions, and missing
+// batch sources, without which feature views cannot be materialized.
+func MigrateLegacyDefinitions(featureTables []*core.FeatureTable, featureSets []*core.FeatureSet) *Migration {
+	migration := &Migration{}
+	for _, featureTable := range featureTables {
+		migration.addFeatureTable(featureTable)
+	}
+	entities := make(map[string]*core.Entity)
+	for _, featureSet := range featureSets {
+		migration.addFeatureSet(featureSet, entities)
+	}
+	return migration
+}
+
+// HasIssues reports whether any part of the legacy definitions could not be mapped.
+func (m *Migration) HasIssues() bool {
+	return len(m.Issues) > 0
+}
+
+// WriteReport writes a human readable report of the migration, listing the converted objects and issues.
+func (m *Migration) WriteReport(w io.Writer) error {
+	var lines []string
+	for _, entity := range m.Entities {
+		lines = append(lines, fmt.Sprintf("+ entity %s/%s", entity.GetSpec().GetProject(), entity.GetSpec().GetName()))
+	}
+	for _, featureView := range m.FeatureViews {
+		lines = append(lines, fmt.Sprintf("+ feature view %s/%s", featureView.GetSpec().GetProject(), featureView.GetSpec().GetName()))
+	}
+	for _, issue := range m.Issues {
+		lines = append(lines, "! "+issue.String())
+	}
+	lines = append(lines, fmt.Sprintf("Migration: %d feature views, %d entities, %d issues.",
+		len(m.FeatureViews), len(m.Entities), len(m.Issues)))
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Migration) addIssue(kind string, project string, name string, field string, reason string) {
+	m.Issues = append(m.Issues, MigrationIssue{Kind: kind, Project: project, Name: name, Field: field, Reason: reason})
+}
+
+func (m *Migration) addFeatureTable(featureTable *core.FeatureTable) {
+	spec := featureTable.GetSpec()
+	featureViewSpec := &core.FeatureViewSpec{
+		Name:         spec.GetName(),
+		Project:      spec.GetProject(),
+		Entities:     spec.GetEntities(),
+		Features:     spec.GetFeatures(),
+		Tags:         spec.GetLabels(),
+		Ttl:          spec.GetMaxAge(),
+		BatchSource:  spec.GetBatchSource(),
+		StreamSource: spec.GetStreamSource(),
+		Online:       true,
+	}
+	if spec.GetBatchSource() == nil {
+		m.addIssue("feature table", spec.GetProject(), spec.GetName(), "batch_source", "feature views require a batch source")
+	}
+
+	featureView := &core.FeatureView{Spec: featureViewSpec}
+	if meta := featureTable.GetMeta(); meta != nil {
+		featureView.Meta = &core.FeatureViewMeta{
+			CreatedTimestamp:     meta.GetCreatedTimestamp(),
+			LastUpdatedTimestamp: meta.GetLastUpdatedTimestamp(),
+		}
+	}
+	// Cloned so that the feature view shares no state with the feature table.
+	m.FeatureViews = append(m.FeatureViews, proto.Clone(featureView).(*core.FeatureView))
+}
+
+// Converts the feature set, adding its entities unless already added by another feature set of the project.
+func (m *Migration) addFeatureSet(featureSet *core.FeatureSet, entities map[string]*core.Entity) {
+	spec := featureSet.GetSpec()
+	project, name := spec.GetProject(), spec.GetName()
+	addIssue := func(field string, format string, args ...interface{}) {
+		m.addIssue("feature set", project, name, field, fmt.Sprintf(format, args...))
+	}
+	featureViewSpec := &core.FeatureViewSpec{
+		Name:    name,
+		Project: project,
+		Tags:    spec.GetLabels(),
+		Ttl:     spec.GetMaxAge(),
+		Online:  true,
+	}
+
+	for _, entitySpec := range spec.GetEntities() {
+		featureViewSpec.Entities = append(featureViewSpec.Entities, entitySpec.GetName())
+		key := project + "/" + entitySpec.GetName()
+		existing, ok := entities[key]
+		switch {
+		case !ok:
+			entity := &core.Entity{Spec: &core.EntitySpecV2{
+				Name:      entitySpec.GetName(),
+				Project:   project,
+				ValueType: entitySpec.GetValueType(),
+				JoinKey:   entitySpec.GetName(),
+			}}
+			entities[key] = entity
+			m.Entities = append(m.Entities, entity)
+		case existing.GetSpec().GetValueType() != entitySpec.GetValueType():
+			addIssue(fmt.Sprintf("entities[%s]", entitySpec.GetName()), "entity is defined as %s by another feature set, not %s",
+				existing.GetSpec().GetValueType(), entitySpec.GetValueType())
+		}
+	}
+
+	for _, feature := range spec.GetFeatures() {
+		featureViewSpec.Features = append(featureViewSpec.Features, &core.FeatureSpecV2{
+			Name:      feature.GetName(),
+			ValueType: feature.GetValueType(),
+			Labels:    feature.GetLabels(),
+		})
+		// Presence, shape and domain constraints are all oneofs with no equivalent in FeatureSpecV2.
+		message := proto.MessageReflect(feature)
+		oneofs := message.Descriptor().Oneofs()
+		var constraints []string
+		for i := 0; i < oneofs.Len(); i++ {
+			if field := message.WhichOneof(oneofs.Get(i)); field != nil {
+				constraints = append(constraints, string(field.Name()))
+			}
+		}
+		sort.Strings(constraints)
+		for _, constraint := range constraints {
+			addIssue(fmt.Sprintf("features[%s].%s", feature.GetName(), constraint), "feature constraints are not supported by feature views")
+		}
+	}
+
+	if spec.GetVersion() > 0 {
+		addIssue("version", "feature views are not versioned, version %d is dropped", spec.GetVersion())
+	}
+	switch source := spec.GetSource(); {
+	case source == nil:
+	case source.GetKafkaSourceConfig() != nil:
+		kafka := source.GetKafkaSourceConfig()
+		featureViewSpec.StreamSource = &core.DataSource{
+			Type:                core.DataSource_STREAM_KAFKA,
+			DataSourceClassType: kafkaSourceClassType,
+			Options: &core.DataSource_KafkaOptions_{KafkaOptions: &core.DataSource_KafkaOptions{
+				BootstrapServers: kafka.GetBootstrapServers(),
+				Topic:            kafka.GetTopic(),
+			}},
+		}
+		addIssue("source", "stream sources require a message format and an event timestamp column")
+		if kafka.GetPartitions() != 0 || kafka.GetReplicationFactor() != 0 {
+			addIssue("source.kafka_source_config", "topic partitions and replication factor are not managed by feature views")
+		}
+	default:
+		addIssue("source", "unsupported source type %s", source.GetType())
+	}
+	// Feature sets were ingested from streams only, so there is no batch source to map.
+	addIssue("batch_source", "feature views require a batch source")
+
+	featureView := &core.FeatureView{Spec: featureViewSpec}
+	if meta := featureSet.GetMeta(); meta != nil {
+		featureView.Meta = &core.FeatureViewMeta{CreatedTimestamp: meta.GetCreatedTimestamp()}
+	}
+	m.FeatureViews = append(m.FeatureViews, proto.Clone(featureView).(*core.FeatureView))
+}
@@ -0,0 +1,154 @@
+package feast
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	v0 "github.com/feast-dev/feast/sdk/go/protos/tensorflow_metadata/proto/v0"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMigrateFeatureTable(t *testing.T) {
+	created := timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	batchSource := FileSource{Path: "s3://bucket/driver_stats.parquet", EventTimestampColumn: "event_timestamp"}.Proto()
+	featureTables := []*core.FeatureTable{
+		{
+			Spec: &core.FeatureTableSpec{
+				Name:        "driver_stats",
+				Project:     "driver_project",
+				Entities:    []string{"driver"},
+				Features:    []*core.FeatureSpecV2{{Name: "rating", ValueType: types.ValueType_DOUBLE}},
+				Labels:      map[string]string{"team": "drivers"},
+				MaxAge:      durationpb.New(24 * time.Hour),
+				BatchSource: batchSource,
+			},
+			Meta: &core.FeatureTableMeta{CreatedTimestamp: created, Revision: 3},
+		},
+		{Spec: &core.FeatureTableSpec{Name: "vehicle_stats", Project: "driver_project", Entities: []string{"vehicle"}}},
+	}
+
+	migration := MigrateLegacyDefinitions(featureTables, nil)
+	want := &Migration{
+		FeatureViews: []*core.FeatureView{
+			{
+				Spec: &core.FeatureViewSpec{
+					Name:        "driver_stats",
+					Project:     "driver_project",
+					Entities:    []string{"driver"},
+					Features:    []*core.FeatureSpecV2{{Name: "rating", ValueType: types.ValueType_DOUBLE}},
+					Tags:        map[string]string{"team": "drivers"},
+					Ttl:         durationpb.New(24 * time.Hour),
+					BatchSource: batchSource,
+					Online:      true,
+				},
+				Meta: &core.FeatureViewMeta{CreatedTimestamp: created},
+			},
+			{Spec: &core.FeatureViewSpec{Name: "vehicle_stats", Project: "driver_project", Entities: []string{"vehicle"}, Online: true}},
+		},
+		Issues: []MigrationIssue{{
+			Kind:    "feature table",
+			Project: "driver_project",
+			Name:    "vehicle_stats",
+			Field:   "batch_source",
+			Reason:  "feature views require a batch source",
+		}},
+	}
+	if diff := cmp.Diff(want, migration, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected migration (-want +got):\n%s", diff)
+	}
+	if migration.FeatureViews[0].Spec.BatchSource == batchSource {
+		t.Error("Expected feature view to not share state with the feature table")
+	}
+}
+
+func TestMigrateFeatureSets(t *testing.T) {
+	featureSets := []*core.FeatureSet{
+		{Spec: &core.FeatureSetSpec{
+			Name:     "driver_stats",
+			Project:  "driver_project",
+			Entities: []*core.EntitySpec{{Name: "driver_id", ValueType: types.ValueType_INT64}},
+			Features: []*core.FeatureSpec{
+				{Name: "rating", ValueType: types.ValueType_DOUBLE, Labels: map[string]string{"unit": "stars"}},
+				{
+					Name:                "trips",
+					ValueType:           types.ValueType_INT64,
+					DomainInfo:          &core.FeatureSpec_IntDomain{IntDomain: &v0.IntDomain{}},
+					PresenceConstraints: &core.FeatureSpec_Presence{Presence: &v0.FeaturePresence{}},
+				},
+			},
+			MaxAge: durationpb.New(time.Hour),
+			Source: &core.Source{
+				Type: core.SourceType_KAFKA,
+				SourceConfig: &core.Source_KafkaSourceConfig{KafkaSourceConfig: &core.KafkaSourceConfig{
+					BootstrapServers: "kafka:9092",
+					Topic:            "driver_stats",
+				}},
+			},
+			Version: 2,
+		}},
+		{Spec: &core.FeatureSetSpec{
+			Name:     "driver_ratings",
+			Project:  "driver_project",
+			Entities: []*core.EntitySpec{{Name: "driver_id", ValueType: types.ValueType_STRING}},
+		}},
+	}
+
+	migration := MigrateLegacyDefinitions(nil, featureSets)
+	wantEntities := []*core.Entity{{Spec: &core.EntitySpecV2{
+		Name:      "driver_id",
+		Project:   "driver_project",
+		ValueType: types.ValueType_INT64,
+		JoinKey:   "driver_id",
+	}}}
+	if diff := cmp.Diff(wantEntities, migration.Entities, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected entities (-want +got):\n%s", diff)
+	}
+	wantFeatureView := &core.FeatureView{Spec: &core.FeatureViewSpec{
+		Name:     "driver_stats",
+		Project:  "driver_project",
+		Entities: []string{"driver_id"},
+		Features: []*core.FeatureSpecV2{
+			{Name: "rating", ValueType: types.ValueType_DOUBLE, Labels: map[string]string{"unit": "stars"}},
+			{Name: "trips", ValueType: types.ValueType_INT64},
+		},
+		Ttl:    durationpb.New(time.Hour),
+		Online: true,
+		StreamSource: &core.DataSource{
+			Type:                core.DataSource_STREAM_KAFKA,
+			DataSourceClassType: kafkaSourceClassType,
+			Options: &core.DataSource_KafkaOptions_{KafkaOptions: &core.DataSource_KafkaOptions{
+				BootstrapServers: "kafka:9092",
+				Topic:            "driver_stats",
+			}},
+		},
+	}}
+	if diff := cmp.Diff(wantFeatureView, migration.FeatureViews[0], protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected feature view (-want +got):\n%s", diff)
+	}
+
+	var report bytes.Buffer
+	if err := migration.WriteReport(&report); err != nil {
+		t.Fatal(err)
+	}
+	wantReport := `+ entity driver_project/driver_id
++ feature view driver_project/driver_stats
++ feature view driver_project/driver_ratings
+! feature set driver_project/driver_stats: features[trips].int_domain: feature constraints are not supported by feature views
+! feature set driver_project/driver_stats: features[trips].presence: feature constraints are not supported by feature views
+! feature set driver_project/driver_stats: version: feature views are not versioned, version 2 is dropped
+! feature set driver_project/driver_stats: source: stream sources require a message format and an event timestamp column
+! feature set driver_project/driver_stats: batch_source: feature views require a batch source
+! feature set driver_project/driver_ratings: entities[driver_id]: entity is defined as INT64 by another feature set, not STRING
+! feature set driver_project/driver_ratings: batch_source: feature views require a batch source
+Migration: 2 feature views, 1 entities, 7 issues.
+`
+	if diff := cmp.Diff(wantReport, report.String()); diff != "" {
+		t.Errorf("Unexpected report (-want +got):\n%s", diff)
+	}
+}
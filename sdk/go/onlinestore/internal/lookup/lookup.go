@@ -0,0 +1,207 @@
+// Package lookup resolves online features requests against feature views and builds their responses as Java serving
+// does, for the online stores of package onlinestore.
+package lookup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FeatureViewSource looks up the feature views features are read from, ie. a *registry.Registry.
+type FeatureViewSource interface {
+	GetFeatureView(project string, name string) (*feast.FeatureView, error)
+}
+
+// EntityKey returns the entity key of an entity row, with entities sorted by name.
+func EntityKey(row feast.Row) *types.EntityKey {
+	entityKey := &types.EntityKey{}
+	for name := range row {
+		entityKey.JoinKeys = append(entityKey.JoinKeys, name)
+	}
+	sort.Strings(entityKey.JoinKeys)
+	for _, name := range entityKey.JoinKeys {
+		entityKey.EntityValues = append(entityKey.EntityValues, row[name])
+	}
+	return entityKey
+}
+
+// CheckRow checks that a row of the feature view holds a value per entity of the feature view, an event timestamp,
+// and values of the types of the features of the feature view. Join keys themselves are not checked, as feature
+// views only reference entities by name.
+func CheckRow(fv *feast.FeatureView, entities feast.Row, values map[string]*types.Value, eventTimestamp time.Time) error {
+	if len(entities) == 0 {
+		return fmt.Errorf("entities must be provided")
+	}
+	if len(entities) != len(fv.Entities()) {
+		return fmt.Errorf("got %d entities, feature view %s has entities %v", len(entities), fv.Name(), fv.Entities())
+	}
+	if eventTimestamp.IsZero() {
+		return fmt.Errorf("event timestamp must be provided")
+	}
+	for name, value := range values {
+		feature, ok := fv.Feature(name)
+		if !ok {
+			return fmt.Errorf("feature %s does not exist in feature view %s", name, fv.Name())
+		}
+		if !hasValueType(value, feature.ValueType) {
+			return fmt.Errorf("value %v of feature %s is not of type %s", value, name, feature.ValueType)
+		}
+	}
+	return nil
+}
+
+// Ref is a requested feature along with the feature view it belongs to.
+type Ref struct {
+	FeatureView *feast.FeatureView
+	Feature     feast.Feature
+}
+
+// String returns the reference of the feature in the format feature_view:feature.
+func (ref Ref) String() string {
+	return ref.FeatureView.Name() + ":" + ref.Feature.Name
+}
+
+// Request is an online features request resolved against the feature views of a project.
+type Request struct {
+	Project    string
+	Refs       []Ref
+	EntityRows []feast.Row
+	// Names of the requested feature views, in order of first reference.
+	FeatureViews []string
+}
+
+// Resolve resolves the features of the request in the given project, unless the request overrides it.
+func Resolve(req *feast.OnlineFeaturesRequest, project string, source FeatureViewSource) (*Request, error) {
+	if req.Project != "" {
+		project = req.Project
+	}
+	if len(req.Features) == 0 {
+		return nil, fmt.Errorf("features must be provided")
+	}
+	if len(req.Entities) == 0 {
+		return nil, fmt.Errorf("entities must be provided")
+	}
+
+	resolved := &Request{Project: project, EntityRows: req.Entities}
+	featureViews := make(map[string]*feast.FeatureView)
+	for _, ref := range req.Features {
+		parts := strings.Split(ref, ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.Contains(ref, "/") {
+			return nil, fmt.Errorf(feast.ErrInvalidFeatureRef, ref)
+		}
+		featureView, ok := featureViews[parts[0]]
+		if !ok {
+			var err error
+			if featureView, err = source.GetFeatureView(project, parts[0]); err != nil {
+				return nil, err
+			}
+			featureViews[parts[0]] = featureView
+			resolved.FeatureViews = append(resolved.FeatureViews, parts[0])
+		}
+		feature, ok := featureView.Feature(parts[1])
+		if !ok {
+			return nil, fmt.Errorf("feature %s does not exist in feature view %s of project %s", parts[1], parts[0], project)
+		}
+		resolved.Refs = append(resolved.Refs, Ref{FeatureView: featureView, Feature: feature})
+	}
+	return resolved, nil
+}
+
+// Feature is a feature value read from an online store, along with the event timestamp of its entity row.
+type Feature struct {
+	Value          *types.Value
+	EventTimestamp *timestamppb.Timestamp
+}
+
+// Response builds the response to the request from the features read for each entity row, nil for features that
+// were not found, evaluating the TTL of feature views at the given time as Java serving does.
+func (r *Request) Response(features [][]*Feature, now time.Time) *feast.OnlineFeaturesResponse {
+	resp := &serving.GetOnlineFeaturesResponse{
+		Metadata: &serving.GetOnlineFeaturesResponseMetadata{FeatureNames: &serving.FeatureList{}},
+	}
+	for i, ref := range r.Refs {
+		resp.Metadata.FeatureNames.Val = append(resp.Metadata.FeatureNames.Val, ref.String())
+		vector := &serving.GetOnlineFeaturesResponse_FeatureVector{}
+		for _, row := range features {
+			feature := row[i]
+			if feature == nil || !hasValueType(feature.Value, ref.Feature.ValueType) {
+				vector.Values = append(vector.Values, &types.Value{})
+				vector.Statuses = append(vector.Statuses, serving.FieldStatus_NOT_FOUND)
+				vector.EventTimestamps = append(vector.EventTimestamps, &timestamppb.Timestamp{})
+				continue
+			}
+			vector.Values = append(vector.Values, feature.Value)
+			vector.Statuses = append(vector.Statuses, featureStatus(feature, ref.FeatureView.TTL(), now))
+			vector.EventTimestamps = append(vector.EventTimestamps, feature.EventTimestamp)
+		}
+		resp.Results = append(resp.Results, vector)
+	}
+	return &feast.OnlineFeaturesResponse{RawResponse: resp}
+}
+
+// Returns the status of a stored feature. Values are outside of the max age once the TTL passed since their event
+// timestamp, compared in seconds. A zero TTL never expires.
+func featureStatus(feature *Feature, ttl time.Duration, now time.Time) serving.FieldStatus {
+	age := now.Unix() - feature.EventTimestamp.GetSeconds()
+	_, isNull := feature.Value.GetVal().(*types.Value_NullVal)
+	switch {
+	case ttl > 0 && age > int64(ttl/time.Second):
+		return serving.FieldStatus_OUTSIDE_MAX_AGE
+	case feature.Value.GetVal() == nil || isNull:
+		return serving.FieldStatus_NULL_VALUE
+	default:
+		return serving.FieldStatus_PRESENT
+	}
+}
+
+// Reports whether the value is null or of the given type. Values of other types are treated as not found by Java
+// serving, ie. after the type of a feature changed.
+func hasValueType(value *types.Value, valueType types.ValueType_Enum) bool {
+	var actual types.ValueType_Enum
+	switch value.GetVal().(type) {
+	case nil:
+		return true
+	case *types.Value_BytesVal:
+		actual = types.ValueType_BYTES
+	case *types.Value_StringVal:
+		actual = types.ValueType_STRING
+	case *types.Value_Int32Val:
+		actual = types.ValueType_INT32
+	case *types.Value_Int64Val:
+		actual = types.ValueType_INT64
+	case *types.Value_DoubleVal:
+		actual = types.ValueType_DOUBLE
+	case *types.Value_FloatVal:
+		actual = types.ValueType_FLOAT
+	case *types.Value_BoolVal:
+		actual = types.ValueType_BOOL
+	case *types.Value_UnixTimestampVal:
+		actual = types.ValueType_UNIX_TIMESTAMP
+	case *types.Value_BytesListVal:
+		actual = types.ValueType_BYTES_LIST
+	case *types.Value_StringListVal:
+		actual = types.ValueType_STRING_LIST
+	case *types.Value_Int32ListVal:
+		actual = types.ValueType_INT32_LIST
+	case *types.Value_Int64ListVal:
+		actual = types.ValueType_INT64_LIST
+	case *types.Value_DoubleListVal:
+		actual = types.ValueType_DOUBLE_LIST
+	case *types.Value_FloatListVal:
+		actual = types.ValueType_FLOAT_LIST
+	case *types.Value_BoolListVal:
+		actual = types.ValueType_BOOL_LIST
+	case *types.Value_UnixTimestampListVal:
+		actual = types.ValueType_UNIX_TIMESTAMP_LIST
+	case *types.Value_NullVal:
+		return true
+	}
+	return actual == valueType
+}
@@ -0,0 +1,80 @@
+// Package onlinestore reads and writes feature values directly in Feast online stores, using the data format
+// described by docs/specs/online_store_format.md, without going through Feast serving.
+package onlinestore
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+)
+
+// FeatureViewSource looks up the feature views features are read from, ie. a *registry.Registry.
+type FeatureViewSource interface {
+	GetFeatureView(project string, name string) (*feast.FeatureView, error)
+}
+
+// SerializeEntityKey serializes the entity key as the Python SDK and Java serving do to look up entity rows in online
+// stores: join keys are sorted, and their names and values written as little endian type tags followed by their
+// bytes. Only string, bytes, int32 and int64 values are supported, int64 values being truncated to 32 bits for
+// compatibility.
+func SerializeEntityKey(entityKey *types.EntityKey) ([]byte, error) {
+	if len(entityKey.GetJoinKeys()) != len(entityKey.GetEntityValues()) {
+		return nil, fmt.Errorf("entity key has %d join keys but %d values", len(entityKey.GetJoinKeys()), len(entityKey.GetEntityValues()))
+	}
+	indices := make([]int, len(entityKey.GetJoinKeys()))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return entityKey.GetJoinKeys()[indices[i]] < entityKey.GetJoinKeys()[indices[j]]
+	})
+
+	var buf []byte
+	for _, i := range indices {
+		buf = appendUint32(buf, uint32(types.ValueType_STRING))
+		buf = append(buf, entityKey.GetJoinKeys()[i]...)
+	}
+	for _, i := range indices {
+		value := entityKey.GetEntityValues()[i]
+		var valueType types.ValueType_Enum
+		var valueBytes []byte
+		switch val := value.GetVal().(type) {
+		case *types.Value_StringVal:
+			valueType, valueBytes = types.ValueType_STRING, []byte(val.StringVal)
+		case *types.Value_BytesVal:
+			valueType, valueBytes = types.ValueType_BYTES, val.BytesVal
+		case *types.Value_Int32Val:
+			valueType, valueBytes = types.ValueType_INT32, appendUint32(nil, uint32(val.Int32Val))
+		case *types.Value_Int64Val:
+			// The Python SDK packs int64 values as 32 bit integers, which Java serving replicates.
+			valueType, valueBytes = types.ValueType_INT64, appendUint32(nil, uint32(val.Int64Val))
+		default:
+			return nil, fmt.Errorf("unsupported value %v of entity %s, entity values must be strings, bytes or integers", value, entityKey.GetJoinKeys()[i])
+		}
+		buf = appendUint32(buf, uint32(valueType))
+		buf = appendUint32(buf, uint32(len(valueBytes)))
+		buf = append(buf, valueBytes...)
+	}
+	return buf, nil
+}
+
+func appendUint32(buf []byte, value uint32) []byte {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], value)
+	return append(buf, b[:]...)
+}
+
+// FeatureRow holds feature values of a feature view for an entity row at an event timestamp.
+type FeatureRow struct {
+	// Entities holds the values of the entities of the feature view, by join key.
+	Entities feast.Row
+	// Values holds the feature values by feature name. Features of the feature view that are not set are left as is.
+	Values         map[string]*types.Value
+	EventTimestamp time.Time
+	// CreatedTimestamp is optional, and only stored by the SQLite online store.
+	CreatedTimestamp time.Time
+}
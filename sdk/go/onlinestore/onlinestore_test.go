@@ -0,0 +1,91 @@
+package onlinestore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+// featureViewMap is a FeatureViewSource holding the feature views of a single project by name.
+type featureViewMap map[string]*feast.FeatureView
+
+func (m featureViewMap) GetFeatureView(project string, name string) (*feast.FeatureView, error) {
+	featureView, ok := m[name]
+	if !ok {
+		return nil, fmt.Errorf("feature view %s does not exist in project %s", name, project)
+	}
+	return featureView, nil
+}
+
+func testFeatureViews() featureViewMap {
+	source := feast.FileSource{Path: "driver_stats.parquet", EventTimestampColumn: "event_timestamp"}
+	return featureViewMap{
+		"driver_stats": feast.NewFeatureView("driver_stats", []string{"driver"}, source,
+			feast.Feature{Name: "rating", ValueType: types.ValueType_DOUBLE},
+			feast.Feature{Name: "trips", ValueType: types.ValueType_INT64},
+		).WithTTL(time.Hour),
+		"driver_profile": feast.NewFeatureView("driver_profile", []string{"driver"}, source,
+			feast.Feature{Name: "name", ValueType: types.ValueType_STRING},
+		),
+	}
+}
+
+func TestSerializeEntityKey(t *testing.T) {
+	tt := []struct {
+		name      string
+		entityKey *types.EntityKey
+		want      []byte
+		wantErr   bool
+	}{
+		{
+			name: "int64 entity",
+			entityKey: &types.EntityKey{
+				JoinKeys:     []string{"driver_id"},
+				EntityValues: []*types.Value{feast.Int64Val(1)},
+			},
+			want: append(append([]byte{2, 0, 0, 0}, "driver_id"...), 4, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0),
+		},
+		{
+			name: "entities sorted by name",
+			entityKey: &types.EntityKey{
+				JoinKeys:     []string{"rider_id", "driver_id"},
+				EntityValues: []*types.Value{feast.StrVal("bob"), feast.Int32Val(-1)},
+			},
+			want: append(append(append(append(append(
+				[]byte{2, 0, 0, 0}, "driver_id"...),
+				2, 0, 0, 0), "rider_id"...),
+				3, 0, 0, 0, 4, 0, 0, 0, 0xff, 0xff, 0xff, 0xff,
+				2, 0, 0, 0, 3, 0, 0, 0), "bob"...),
+		},
+		{
+			name: "unsupported value",
+			entityKey: &types.EntityKey{
+				JoinKeys:     []string{"driver_id"},
+				EntityValues: []*types.Value{feast.DoubleVal(1)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing value",
+			entityKey: &types.EntityKey{
+				JoinKeys: []string{"driver_id"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := SerializeEntityKey(tc.entityKey)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
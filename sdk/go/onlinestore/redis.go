@@ -0,0 +1,233 @@
+package onlinestore
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/internal/murmur3"
+	"github.com/feast-dev/feast/sdk/go/onlinestore/internal/lookup"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/storage"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Prefix of the hash fields holding the event timestamps of entity rows, followed by ":" and the feature view name.
+const redisTimestampPrefix = "_ts"
+
+// KeyFormat is the serialization of entity keys into Redis keys.
+type KeyFormat int
+
+const (
+	// EntityKeyFormat serializes entity keys with SerializeEntityKey followed by the project name,
+	// as written by the Python SDK and read by Java serving.
+	EntityKeyFormat KeyFormat = iota
+	// RedisKeyV2Format serializes entity keys as RedisKeyV2 protos with entities sorted by name,
+	// as described by version 0.10 of the online store format.
+	RedisKeyV2Format
+)
+
+// RedisOnlineStore reads feature values from a Redis online store.
+// Each entity row is stored as a Redis hash keyed by its entity key, with a field per feature holding its
+// serialized Value, and a "_ts:<feature view>" field per feature view holding the serialized event timestamp.
+type RedisOnlineStore struct {
+	client       redis.UniversalClient
+	project      string
+	featureViews FeatureViewSource
+	keyFormat    KeyFormat
+	// Prefix of the keys of entity rows, and whether keys missing an entity row are looked up with the fallback
+	// prefix instead.
+	keyPrefix      string
+	fallback       bool
+	fallbackPrefix string
+	// Returns the current time, against which the TTL of feature views is evaluated.
+	now func() time.Time
+}
+
+var _ feast.Client = (*RedisOnlineStore)(nil)
+
+// NewRedisOnlineStore creates an online store reading features of the given project, unless overridden by requests,
+// from Redis through the given client. Feature views are looked up in the given source, ie. a *registry.Registry.
+func NewRedisOnlineStore(client redis.UniversalClient, project string, featureViews FeatureViewSource) *RedisOnlineStore {
+	return &RedisOnlineStore{
+		client:       client,
+		project:      project,
+		featureViews: featureViews,
+		keyFormat:    EntityKeyFormat,
+		now:          time.Now,
+	}
+}
+
+// WithKeyFormat sets the serialization of entity keys, EntityKeyFormat by default.
+func (s *RedisOnlineStore) WithKeyFormat(format KeyFormat) *RedisOnlineStore {
+	s.keyFormat = format
+	return s
+}
+
+// WithKeyPrefix sets the prefix of the keys of entity rows, empty by default.
+func (s *RedisOnlineStore) WithKeyPrefix(prefix string) *RedisOnlineStore {
+	s.keyPrefix = prefix
+	return s
+}
+
+// WithFallbackPrefix makes entity rows missing from Redis be looked up again with the given prefix instead of the
+// key prefix, ie. while migrating keys to another prefix. The fallback prefix may be empty.
+func (s *RedisOnlineStore) WithFallbackPrefix(prefix string) *RedisOnlineStore {
+	s.fallback = true
+	s.fallbackPrefix = prefix
+	return s
+}
+
+// Close closes the Redis client.
+func (s *RedisOnlineStore) Close() error {
+	return s.client.Close()
+}
+
+// GetFeastServingInfo returns an empty response, as Java serving does.
+func (s *RedisOnlineStore) GetFeastServingInfo(ctx context.Context, in *serving.GetFeastServingInfoRequest) (*serving.GetFeastServingInfoResponse, error) {
+	return &serving.GetFeastServingInfoResponse{}, nil
+}
+
+// GetOnlineFeatures reads the requested features of each entity row from Redis, returning them as Feast serving does.
+// Entity rows are looked up by all the entities they hold. Features that are not stored, or stored with a type other
+// than the type of the feature, are NOT_FOUND, and features older than the TTL of their feature view are
+// OUTSIDE_MAX_AGE.
+func (s *RedisOnlineStore) GetOnlineFeatures(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+	resolved, err := lookup.Resolve(req, s.project, s.featureViews)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(resolved.Refs)+len(resolved.FeatureViews))
+	for _, ref := range resolved.Refs {
+		fields = append(fields, string(redisHashField(ref.FeatureView.Name(), ref.Feature.Name)))
+	}
+	for _, featureView := range resolved.FeatureViews {
+		fields = append(fields, redisTimestampField(featureView))
+	}
+
+	keys := make([]string, len(resolved.EntityRows))
+	for i, row := range resolved.EntityRows {
+		key, err := RedisKey(s.keyFormat, resolved.Project, lookup.EntityKey(row))
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = string(key)
+	}
+	cmds, err := s.hmget(ctx, s.keyPrefix, keys, fields)
+	if err != nil {
+		return nil, err
+	}
+	if s.fallback {
+		// Entity rows none of the fields of which are stored are looked up with the fallback prefix.
+		var missing []int
+		var missingKeys []string
+		for i, cmd := range cmds {
+			if allNil(cmd.Val()) {
+				missing = append(missing, i)
+				missingKeys = append(missingKeys, keys[i])
+			}
+		}
+		if len(missing) > 0 {
+			fallbackCmds, err := s.hmget(ctx, s.fallbackPrefix, missingKeys, fields)
+			if err != nil {
+				return nil, err
+			}
+			for j, i := range missing {
+				cmds[i] = fallbackCmds[j]
+			}
+		}
+	}
+
+	features := make([][]*lookup.Feature, len(cmds))
+	for i, cmd := range cmds {
+		values := cmd.Val()
+		timestamps := make(map[string]*timestamppb.Timestamp, len(resolved.FeatureViews))
+		for j, featureView := range resolved.FeatureViews {
+			if value, ok := values[len(resolved.Refs)+j].(string); ok {
+				timestamp := &timestamppb.Timestamp{}
+				if err := proto.Unmarshal([]byte(value), timestamp); err != nil {
+					return nil, fmt.Errorf("failed to decode event timestamp of feature view %s: %v", featureView, err)
+				}
+				timestamps[featureView] = timestamp
+			}
+		}
+		features[i] = make([]*lookup.Feature, len(resolved.Refs))
+		for j, ref := range resolved.Refs {
+			value, ok := values[j].(string)
+			if !ok {
+				continue
+			}
+			featureValue := &types.Value{}
+			if err := proto.Unmarshal([]byte(value), featureValue); err != nil {
+				return nil, fmt.Errorf("failed to decode value of feature %s: %v", ref, err)
+			}
+			features[i][j] = &lookup.Feature{Value: featureValue, EventTimestamp: timestamps[ref.FeatureView.Name()]}
+		}
+	}
+	return resolved.Response(features, s.now()), nil
+}
+
+// Reads the given fields of the hashes at the given keys with the given prefix, in a single pipeline.
+func (s *RedisOnlineStore) hmget(ctx context.Context, prefix string, keys []string, fields []string) ([]*redis.SliceCmd, error) {
+	pipe := s.client.Pipeline()
+	cmds := make([]*redis.SliceCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.HMGet(ctx, prefix+key, fields...)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to read features from redis: %v", err)
+	}
+	return cmds, nil
+}
+
+// Reports whether none of the values read by HMGET are set.
+func allNil(values []interface{}) bool {
+	for _, value := range values {
+		if value != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// RedisKey returns the Redis key of the entity row with the given entity key in the given project, without prefix.
+func RedisKey(format KeyFormat, project string, entityKey *types.EntityKey) ([]byte, error) {
+	if format == RedisKeyV2Format {
+		if len(entityKey.GetJoinKeys()) != len(entityKey.GetEntityValues()) {
+			return nil, fmt.Errorf("entity key has %d join keys but %d values", len(entityKey.GetJoinKeys()), len(entityKey.GetEntityValues()))
+		}
+		row := make(feast.Row, len(entityKey.GetJoinKeys()))
+		for i, name := range entityKey.GetJoinKeys() {
+			row[name] = entityKey.GetEntityValues()[i]
+		}
+		sorted := lookup.EntityKey(row)
+		return proto.Marshal(&storage.RedisKeyV2{
+			Project:      project,
+			EntityNames:  sorted.JoinKeys,
+			EntityValues: sorted.EntityValues,
+		})
+	}
+	key, err := SerializeEntityKey(entityKey)
+	if err != nil {
+		return nil, err
+	}
+	return append(key, project...), nil
+}
+
+// Returns the hash field of a feature, the little endian bytes of the murmur3 (32 bit) hash of "feature_view:feature".
+func redisHashField(featureView string, feature string) []byte {
+	field := make([]byte, 4)
+	binary.LittleEndian.PutUint32(field, murmur3.Sum32([]byte(featureView+":"+feature)))
+	return field
+}
+
+// Returns the hash field holding the event timestamp of the rows of a feature view.
+func redisTimestampField(featureView string) string {
+	return redisTimestampPrefix + ":" + featureView
+}
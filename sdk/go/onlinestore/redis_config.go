@@ -0,0 +1,131 @@
+package onlinestore
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/go-redis/redis/v8"
+)
+
+// NewRedisClient connects to the Redis instance or cluster configured by a REDIS or REDIS_CLUSTER store, as Java
+// serving does.
+func NewRedisClient(store *core.Store) (redis.UniversalClient, error) {
+	switch store.GetType() {
+	case core.Store_REDIS:
+		options, err := redisOptions(store.GetRedisConfig())
+		if err != nil {
+			return nil, fmt.Errorf("invalid redis config of store %s: %v", store.GetName(), err)
+		}
+		return redis.NewClient(options), nil
+	case core.Store_REDIS_CLUSTER:
+		options, err := redisClusterOptions(store.GetRedisClusterConfig())
+		if err != nil {
+			return nil, fmt.Errorf("invalid redis cluster config of store %s: %v", store.GetName(), err)
+		}
+		return redis.NewClusterClient(options), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s of store %s, expected REDIS or REDIS_CLUSTER", store.GetType(), store.GetName())
+	}
+}
+
+// NewRedisOnlineStoreFromProto creates an online store reading features from the Redis instance or cluster
+// configured by the given store, applying the key prefix and fallback prefix of cluster configs.
+func NewRedisOnlineStoreFromProto(store *core.Store, project string, featureViews FeatureViewSource) (*RedisOnlineStore, error) {
+	client, err := NewRedisClient(store)
+	if err != nil {
+		return nil, err
+	}
+	onlineStore := NewRedisOnlineStore(client, project, featureViews)
+	if store.GetType() == core.Store_REDIS_CLUSTER {
+		config := store.GetRedisClusterConfig()
+		onlineStore.WithKeyPrefix(config.GetKeyPrefix())
+		if config.GetEnableFallback() {
+			onlineStore.WithFallbackPrefix(config.GetFallbackPrefix())
+		}
+	}
+	return onlineStore, nil
+}
+
+// NewRedisWriterFromProto creates a writer of features to the Redis instance or cluster configured by the given
+// store, applying the key prefix of cluster configs.
+func NewRedisWriterFromProto(store *core.Store, project string, featureViews FeatureViewSource) (*RedisWriter, error) {
+	client, err := NewRedisClient(store)
+	if err != nil {
+		return nil, err
+	}
+	writer := NewRedisWriter(client, project, featureViews)
+	if store.GetType() == core.Store_REDIS_CLUSTER {
+		writer.WithKeyPrefix(store.GetRedisClusterConfig().GetKeyPrefix())
+	}
+	return writer, nil
+}
+
+func redisOptions(config *core.Store_RedisConfig) (*redis.Options, error) {
+	if config == nil {
+		return nil, fmt.Errorf("redis config must be provided")
+	}
+	if config.GetHost() == "" || config.GetPort() == 0 {
+		return nil, fmt.Errorf("host and port must be provided")
+	}
+	options := &redis.Options{
+		Addr:            net.JoinHostPort(config.GetHost(), strconv.Itoa(int(config.GetPort()))),
+		MaxRetries:      maxRetries(config.GetMaxRetries()),
+		MinRetryBackoff: time.Duration(config.GetInitialBackoffMs()) * time.Millisecond,
+	}
+	if config.GetSsl() {
+		options.TLSConfig = &tls.Config{ServerName: config.GetHost()}
+	}
+	return options, nil
+}
+
+// Returns the options of a cluster client for the given config, so that configs of Java serving can be loaded as is.
+// Reads are routed as close to the read preference as go-redis allows: MASTER reads from masters, and
+// REPLICA_PREFERRED from replicas, falling back to masters when slots have no healthy replica. go-redis can neither
+// read only from replicas nor fall back from masters to replicas, so REPLICA also falls back to masters, and
+// MASTER_PREFERRED never reads from replicas.
+func redisClusterOptions(config *core.Store_RedisClusterConfig) (*redis.ClusterOptions, error) {
+	if config == nil {
+		return nil, fmt.Errorf("redis cluster config must be provided")
+	}
+	var addrs []string
+	for _, addr := range strings.Split(config.GetConnectionString(), ",") {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return nil, fmt.Errorf("invalid connection string %q: %v", config.GetConnectionString(), err)
+		}
+		addrs = append(addrs, addr)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("connection string must be provided")
+	}
+	options := &redis.ClusterOptions{
+		Addrs:           addrs,
+		MaxRetries:      maxRetries(config.GetMaxRetries()),
+		MinRetryBackoff: time.Duration(config.GetInitialBackoffMs()) * time.Millisecond,
+	}
+	switch config.GetReadFrom() {
+	case core.Store_RedisClusterConfig_MASTER, core.Store_RedisClusterConfig_MASTER_PREFERRED:
+	case core.Store_RedisClusterConfig_REPLICA, core.Store_RedisClusterConfig_REPLICA_PREFERRED:
+		options.ReadOnly = true
+	default:
+		return nil, fmt.Errorf("unsupported read from %s", config.GetReadFrom())
+	}
+	return options, nil
+}
+
+// Returns the max retries of go-redis options for the given max retries of a config. Configs default to no retries,
+// while go-redis defaults to 3 retries and disables them with -1.
+func maxRetries(configured int32) int {
+	if configured == 0 {
+		return -1
+	}
+	return int(configured)
+}
@@ -0,0 +1,235 @@
+package onlinestore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestRedisClusterOptions(t *testing.T) {
+	tt := []struct {
+		name    string
+		config  *core.Store_RedisClusterConfig
+		want    *redis.ClusterOptions
+		wantErr bool
+	}{
+		{
+			name: "master",
+			config: &core.Store_RedisClusterConfig{
+				ConnectionString: "host1:6379, host2:6379",
+				InitialBackoffMs: 100,
+				MaxRetries:       2,
+			},
+			want: &redis.ClusterOptions{
+				Addrs:           []string{"host1:6379", "host2:6379"},
+				MaxRetries:      2,
+				MinRetryBackoff: 100 * time.Millisecond,
+			},
+		},
+		{
+			name: "master preferred",
+			config: &core.Store_RedisClusterConfig{
+				ConnectionString: "host1:6379",
+				ReadFrom:         core.Store_RedisClusterConfig_MASTER_PREFERRED,
+			},
+			want: &redis.ClusterOptions{Addrs: []string{"host1:6379"}, MaxRetries: -1},
+		},
+		{
+			name: "replica",
+			config: &core.Store_RedisClusterConfig{
+				ConnectionString: "host1:6379",
+				ReadFrom:         core.Store_RedisClusterConfig_REPLICA,
+			},
+			want: &redis.ClusterOptions{Addrs: []string{"host1:6379"}, MaxRetries: -1, ReadOnly: true},
+		},
+		{
+			name: "replica preferred",
+			config: &core.Store_RedisClusterConfig{
+				ConnectionString: "host1:6379",
+				ReadFrom:         core.Store_RedisClusterConfig_REPLICA_PREFERRED,
+			},
+			want: &redis.ClusterOptions{Addrs: []string{"host1:6379"}, MaxRetries: -1, ReadOnly: true},
+		},
+		{
+			name:    "missing port",
+			config:  &core.Store_RedisClusterConfig{ConnectionString: "host1:6379,host2"},
+			wantErr: true,
+		},
+		{
+			name:    "missing connection string",
+			config:  &core.Store_RedisClusterConfig{},
+			wantErr: true,
+		},
+		{
+			name:    "missing config",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := redisClusterOptions(tc.config)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(redis.ClusterOptions{})); diff != "" {
+				t.Errorf("options differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewRedisClient(t *testing.T) {
+	tt := []struct {
+		name    string
+		store   *core.Store
+		wantErr bool
+	}{
+		{
+			name: "redis",
+			store: &core.Store{
+				Name:   "online",
+				Type:   core.Store_REDIS,
+				Config: &core.Store_RedisConfig_{RedisConfig: &core.Store_RedisConfig{Host: "localhost", Port: 6379}},
+			},
+		},
+		{
+			name: "redis without port",
+			store: &core.Store{
+				Name:   "online",
+				Type:   core.Store_REDIS,
+				Config: &core.Store_RedisConfig_{RedisConfig: &core.Store_RedisConfig{Host: "localhost"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "redis cluster",
+			store: &core.Store{
+				Name:   "online",
+				Type:   core.Store_REDIS_CLUSTER,
+				Config: &core.Store_RedisClusterConfig_{RedisClusterConfig: &core.Store_RedisClusterConfig{ConnectionString: "localhost:7000"}},
+			},
+		},
+		{
+			name: "redis cluster without config",
+			store: &core.Store{
+				Name:   "online",
+				Type:   core.Store_REDIS_CLUSTER,
+				Config: &core.Store_RedisConfig_{RedisConfig: &core.Store_RedisConfig{Host: "localhost", Port: 6379}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			store:   &core.Store{Name: "online", Type: core.Store_INVALID},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := NewRedisClient(tc.store)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if client != nil {
+				client.Close()
+			}
+		})
+	}
+}
+
+func TestRedisClusterKeyPrefixes(t *testing.T) {
+	now := time.Now()
+	server := miniredis.RunT(t)
+	store := func(keyPrefix string, fallbackPrefix string, enableFallback bool) *core.Store {
+		return &core.Store{
+			Name: "online",
+			Type: core.Store_REDIS_CLUSTER,
+			Config: &core.Store_RedisClusterConfig_{RedisClusterConfig: &core.Store_RedisClusterConfig{
+				ConnectionString: server.Addr(),
+				KeyPrefix:        keyPrefix,
+				EnableFallback:   enableFallback,
+				FallbackPrefix:   fallbackPrefix,
+			}},
+		}
+	}
+	write := func(keyPrefix string, driverID int64, rating float64) {
+		writer, err := NewRedisWriterFromProto(store(keyPrefix, "", false), "driver_project", testFeatureViews())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer writer.Close()
+		_, err = writer.Write(context.Background(), "driver_stats", []FeatureRow{{
+			Entities:       feast.Row{"driver_id": feast.Int64Val(driverID)},
+			Values:         map[string]*types.Value{"rating": feast.DoubleVal(rating)},
+			EventTimestamp: now,
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("v2:", 1, 1.5)
+	write("v1:", 1, 1.0)
+	write("v1:", 2, 2.0)
+	write("", 3, 3.0)
+
+	tt := []struct {
+		name           string
+		keyPrefix      string
+		fallbackPrefix string
+		enableFallback bool
+		want           []*types.Value
+	}{
+		{
+			name:      "key prefix",
+			keyPrefix: "v2:",
+			want:      []*types.Value{feast.DoubleVal(1.5), {}, {}},
+		},
+		{
+			name:           "fallback prefix",
+			keyPrefix:      "v2:",
+			fallbackPrefix: "v1:",
+			enableFallback: true,
+			want:           []*types.Value{feast.DoubleVal(1.5), feast.DoubleVal(2.0), {}},
+		},
+		{
+			name:           "empty fallback prefix",
+			keyPrefix:      "v2:",
+			enableFallback: true,
+			want:           []*types.Value{feast.DoubleVal(1.5), {}, feast.DoubleVal(3.0)},
+		},
+		{
+			name:           "fallback disabled",
+			keyPrefix:      "v2:",
+			fallbackPrefix: "v1:",
+			want:           []*types.Value{feast.DoubleVal(1.5), {}, {}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			onlineStore, err := NewRedisOnlineStoreFromProto(store(tc.keyPrefix, tc.fallbackPrefix, tc.enableFallback), "driver_project", testFeatureViews())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer onlineStore.Close()
+			resp, err := onlineStore.GetOnlineFeatures(context.Background(), &feast.OnlineFeaturesRequest{
+				Features: []string{"driver_stats:rating"},
+				Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}, {"driver_id": feast.Int64Val(2)}, {"driver_id": feast.Int64Val(3)}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, resp.RawResponse.Results[0].Values, protocmp.Transform()); diff != "" {
+				t.Errorf("values differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
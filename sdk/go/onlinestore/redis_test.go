@@ -0,0 +1,212 @@
+package onlinestore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/storage"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Writes the features of an entity row of a feature view to Redis as the Python SDK does.
+func hsetRedisRow(t *testing.T, client redis.UniversalClient, key []byte, featureView string, eventTimestamp time.Time, values map[string]*types.Value) {
+	t.Helper()
+	fields := make(map[string]interface{})
+	for feature, value := range values {
+		data, err := proto.Marshal(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fields[string(redisHashField(featureView, feature))] = data
+	}
+	data, err := proto.Marshal(&timestamppb.Timestamp{Seconds: eventTimestamp.Unix()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields[redisTimestampField(featureView)] = data
+	if err := client.HSet(context.Background(), string(key), fields).Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRedisHashField(t *testing.T) {
+	// murmur3_32("driver_stats:rating") as computed by Guava and mmh3, in little endian
+	want := []byte{0x87, 0xcc, 0xf0, 0x96}
+	if got := redisHashField("driver_stats", "rating"); !cmp.Equal(got, want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+func TestRedisOnlineStoreGetOnlineFeatures(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name      string
+		keyFormat KeyFormat
+		req       feast.OnlineFeaturesRequest
+		want      *serving.GetOnlineFeaturesResponse
+		wantErr   bool
+	}{
+		{
+			name: "statuses",
+			req: feast.OnlineFeaturesRequest{
+				Features: []string{"driver_stats:rating", "driver_stats:trips", "driver_profile:name"},
+				Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}, {"driver_id": feast.Int64Val(2)}, {"driver_id": feast.Int64Val(3)}},
+			},
+			want: &serving.GetOnlineFeaturesResponse{
+				Metadata: &serving.GetOnlineFeaturesResponseMetadata{
+					FeatureNames: &serving.FeatureList{Val: []string{"driver_stats:rating", "driver_stats:trips", "driver_profile:name"}},
+				},
+				Results: []*serving.GetOnlineFeaturesResponse_FeatureVector{
+					{
+						Values:          []*types.Value{feast.DoubleVal(4.5), {}, feast.DoubleVal(3.5)},
+						Statuses:        []serving.FieldStatus{serving.FieldStatus_PRESENT, serving.FieldStatus_NULL_VALUE, serving.FieldStatus_OUTSIDE_MAX_AGE},
+						EventTimestamps: []*timestamppb.Timestamp{{Seconds: now.Add(-time.Minute).Unix()}, {Seconds: now.Add(-time.Minute).Unix()}, {Seconds: now.Add(-2 * time.Hour).Unix()}},
+					},
+					{
+						Values:          []*types.Value{feast.Int64Val(10), {}, {}},
+						Statuses:        []serving.FieldStatus{serving.FieldStatus_PRESENT, serving.FieldStatus_NOT_FOUND, serving.FieldStatus_NOT_FOUND},
+						EventTimestamps: []*timestamppb.Timestamp{{Seconds: now.Add(-time.Minute).Unix()}, {}, {}},
+					},
+					{
+						Values:          []*types.Value{{}, {}, feast.StrVal("alice")},
+						Statuses:        []serving.FieldStatus{serving.FieldStatus_NOT_FOUND, serving.FieldStatus_NOT_FOUND, serving.FieldStatus_PRESENT},
+						EventTimestamps: []*timestamppb.Timestamp{{}, {}, {Seconds: now.Add(-48 * time.Hour).Unix()}},
+					},
+				},
+			},
+		},
+		{
+			name:      "redis key v2",
+			keyFormat: RedisKeyV2Format,
+			req: feast.OnlineFeaturesRequest{
+				Features: []string{"driver_stats:rating"},
+				Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+			},
+			want: &serving.GetOnlineFeaturesResponse{
+				Metadata: &serving.GetOnlineFeaturesResponseMetadata{
+					FeatureNames: &serving.FeatureList{Val: []string{"driver_stats:rating"}},
+				},
+				Results: []*serving.GetOnlineFeaturesResponse_FeatureVector{
+					{
+						Values:          []*types.Value{feast.DoubleVal(1.5)},
+						Statuses:        []serving.FieldStatus{serving.FieldStatus_PRESENT},
+						EventTimestamps: []*timestamppb.Timestamp{{Seconds: now.Unix()}},
+					},
+				},
+			},
+		},
+		{
+			name: "other project",
+			req: feast.OnlineFeaturesRequest{
+				Features: []string{"driver_stats:rating"},
+				Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+				Project:  "other_project",
+			},
+			want: &serving.GetOnlineFeaturesResponse{
+				Metadata: &serving.GetOnlineFeaturesResponseMetadata{
+					FeatureNames: &serving.FeatureList{Val: []string{"driver_stats:rating"}},
+				},
+				Results: []*serving.GetOnlineFeaturesResponse_FeatureVector{
+					{
+						Values:          []*types.Value{{}},
+						Statuses:        []serving.FieldStatus{serving.FieldStatus_NOT_FOUND},
+						EventTimestamps: []*timestamppb.Timestamp{{}},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid feature reference",
+			req: feast.OnlineFeaturesRequest{
+				Features: []string{"driver_project/driver_stats:rating"},
+				Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "undefined feature view",
+			req: feast.OnlineFeaturesRequest{
+				Features: []string{"rider_stats:rating"},
+				Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "undefined feature",
+			req: feast.OnlineFeaturesRequest{
+				Features: []string{"driver_stats:speed"},
+				Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no entities",
+			req: feast.OnlineFeaturesRequest{
+				Features: []string{"driver_stats:rating"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			server := miniredis.RunT(t)
+			client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+
+			rows := []struct {
+				driverID       int64
+				featureView    string
+				eventTimestamp time.Time
+				values         map[string]*types.Value
+			}{
+				{1, "driver_stats", now.Add(-time.Minute), map[string]*types.Value{"rating": feast.DoubleVal(4.5), "trips": feast.Int64Val(10)}},
+				{2, "driver_stats", now.Add(-time.Minute), map[string]*types.Value{"rating": {}, "trips": feast.StrVal("ten")}},
+				{3, "driver_stats", now.Add(-2 * time.Hour), map[string]*types.Value{"rating": feast.DoubleVal(3.5)}},
+				{3, "driver_profile", now.Add(-48 * time.Hour), map[string]*types.Value{"name": feast.StrVal("alice")}},
+			}
+			for _, row := range rows {
+				key, err := RedisKey(EntityKeyFormat, "driver_project", &types.EntityKey{
+					JoinKeys:     []string{"driver_id"},
+					EntityValues: []*types.Value{feast.Int64Val(row.driverID)},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				hsetRedisRow(t, client, key, row.featureView, row.eventTimestamp, row.values)
+			}
+			v2Key, err := proto.Marshal(&storage.RedisKeyV2{
+				Project:      "driver_project",
+				EntityNames:  []string{"driver_id"},
+				EntityValues: []*types.Value{feast.Int64Val(1)},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			hsetRedisRow(t, client, v2Key, "driver_stats", now, map[string]*types.Value{"rating": feast.DoubleVal(1.5)})
+
+			store := NewRedisOnlineStore(client, "driver_project", testFeatureViews()).WithKeyFormat(tc.keyFormat)
+			store.now = func() time.Time { return now }
+			defer store.Close()
+
+			got, err := store.GetOnlineFeatures(context.Background(), &tc.req)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got.RawResponse, protocmp.Transform()); diff != "" {
+				t.Errorf("response differs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package onlinestore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/onlinestore/internal/lookup"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Script writing the fields given as arguments to the hash of an entity row, unless the event timestamp stored for
+// the feature view is as recent, in seconds, as the event timestamp of the row. Timestamps are stored as serialized
+// Timestamp protos, the seconds of which are the varint of field 1. Returns 1 if the row was written, 0 otherwise.
+//
+// KEYS[1]: the key of the entity row.
+// ARGV[1]: the hash field holding the event timestamp of the feature view.
+// ARGV[2]: the event timestamp of the row, in seconds.
+// ARGV[3], ARGV[4]: the time the key should expire at, in seconds, and in milliseconds from now. Empty to leave the
+// expiry of the key as is, or 0 to remove it. Expiries are only extended, and not set on existing keys without one.
+// ARGV[5...]: the fields and values to set.
+var redisWriteScript = redis.NewScript(`
+local function timestamp_seconds(ts)
+  if not ts then
+    return 0
+  end
+  local i = 1
+  while i <= #ts do
+    local tag = string.byte(ts, i)
+    i = i + 1
+    local value, scale = 0, 1
+    repeat
+      local b = string.byte(ts, i)
+      if not b then
+        return 0
+      end
+      i = i + 1
+      value = value + (b % 128) * scale
+      scale = scale * 128
+    until b < 128
+    if tag == 8 then
+      return value
+    end
+  end
+  return 0
+end
+
+local stored = timestamp_seconds(redis.call('HGET', KEYS[1], ARGV[1]))
+if stored ~= 0 and tonumber(ARGV[2]) <= stored then
+  return 0
+end
+local pttl = redis.call('PTTL', KEYS[1])
+redis.call('HSET', KEYS[1], unpack(ARGV, 5))
+if ARGV[3] == '0' then
+  if pttl >= 0 then
+    redis.call('PERSIST', KEYS[1])
+  end
+elseif ARGV[3] ~= '' and pttl ~= -1 and (pttl < 0 or pttl < tonumber(ARGV[4])) then
+  redis.call('EXPIREAT', KEYS[1], ARGV[3])
+end
+return 1
+`)
+
+// RedisWriter writes feature values to a Redis online store, in the format read by Java serving and RedisOnlineStore.
+type RedisWriter struct {
+	client       redis.UniversalClient
+	project      string
+	featureViews FeatureViewSource
+	keyFormat    KeyFormat
+	keyPrefix    string
+	keyExpiry    bool
+}
+
+// NewRedisWriter creates a writer of features of the given project to Redis through the given client. Feature views
+// are looked up in the given source, ie. a *registry.Registry.
+func NewRedisWriter(client redis.UniversalClient, project string, featureViews FeatureViewSource) *RedisWriter {
+	return &RedisWriter{
+		client:       client,
+		project:      project,
+		featureViews: featureViews,
+		keyFormat:    EntityKeyFormat,
+	}
+}
+
+// WithKeyFormat sets the serialization of entity keys, EntityKeyFormat by default.
+func (w *RedisWriter) WithKeyFormat(format KeyFormat) *RedisWriter {
+	w.keyFormat = format
+	return w
+}
+
+// WithKeyPrefix sets the prefix of the keys of entity rows, empty by default.
+func (w *RedisWriter) WithKeyPrefix(prefix string) *RedisWriter {
+	w.keyPrefix = prefix
+	return w
+}
+
+// WithKeyExpiry sets whether written keys expire once the TTL of the feature view passed since the event timestamp,
+// when their values would be outside of the max age anyway. As keys hold the features of all the feature views
+// sharing their entities, the expiry of a key is only ever extended, and removed when writing a feature view without
+// TTL. Disabled by default.
+func (w *RedisWriter) WithKeyExpiry(enabled bool) *RedisWriter {
+	w.keyExpiry = enabled
+	return w
+}
+
+// Close closes the Redis client.
+func (w *RedisWriter) Close() error {
+	return w.client.Close()
+}
+
+// Write writes the rows of the given feature view in a pipelined round trip, returning the number of rows written.
+// As the Python SDK does, rows are skipped unless their event timestamp is more recent, in seconds, than the event
+// timestamp stored for their entity row, and only the most recent of the rows sharing entities is written. Each row
+// is compared and written atomically by a script, so that concurrent writers never overwrite newer rows.
+func (w *RedisWriter) Write(ctx context.Context, featureView string, rows []FeatureRow) (int, error) {
+	fv, err := w.featureViews.GetFeatureView(w.project, featureView)
+	if err != nil {
+		return 0, err
+	}
+
+	// Encode rows, keeping the most recent row of each key.
+	var keys []string
+	encoded := make(map[string]*encodedRow, len(rows))
+	for i, row := range rows {
+		entityKey := lookup.EntityKey(row.Entities)
+		if err := lookup.CheckRow(fv, row.Entities, row.Values, row.EventTimestamp); err != nil {
+			return 0, fmt.Errorf("invalid row %d: %v", i, err)
+		}
+		key, err := RedisKey(w.keyFormat, w.project, entityKey)
+		if err != nil {
+			return 0, fmt.Errorf("invalid row %d: %v", i, err)
+		}
+		fields, err := encodeRedisRow(fv, row)
+		if err != nil {
+			return 0, fmt.Errorf("invalid row %d: %v", i, err)
+		}
+		prefixed := w.keyPrefix + string(key)
+		previous, ok := encoded[prefixed]
+		if !ok {
+			keys = append(keys, prefixed)
+		} else if previous.eventSeconds > row.EventTimestamp.Unix() {
+			continue
+		}
+		encoded[prefixed] = &encodedRow{fields: fields, eventSeconds: row.EventTimestamp.Unix()}
+	}
+
+	args := make([][]interface{}, len(keys))
+	for i, key := range keys {
+		args[i] = w.scriptArgs(fv, encoded[key])
+	}
+	cmds, err := w.runWriteScript(ctx, keys, args, redisWriteScript.EvalSha)
+	if err != nil {
+		return 0, err
+	}
+	// Scripts missing from the script cache of a node are evaluated again, which loads them.
+	var missing []int
+	for i, cmd := range cmds {
+		if err := cmd.Err(); err != nil && strings.HasPrefix(err.Error(), "NOSCRIPT") {
+			missing = append(missing, i)
+		}
+	}
+	if len(missing) > 0 {
+		missingKeys := make([]string, len(missing))
+		missingArgs := make([][]interface{}, len(missing))
+		for j, i := range missing {
+			missingKeys[j], missingArgs[j] = keys[i], args[i]
+		}
+		evalCmds, err := w.runWriteScript(ctx, missingKeys, missingArgs, redisWriteScript.Eval)
+		if err != nil {
+			return 0, err
+		}
+		for j, i := range missing {
+			cmds[i] = evalCmds[j]
+		}
+	}
+
+	written := 0
+	for _, cmd := range cmds {
+		result, err := cmd.Int()
+		if err != nil {
+			return written, fmt.Errorf("failed to write features to redis: %v", err)
+		}
+		written += result
+	}
+	return written, nil
+}
+
+// Runs the write script for each key with the given arguments in a single pipeline. Errors of individual scripts
+// are left in their commands.
+func (w *RedisWriter) runWriteScript(ctx context.Context, keys []string, args [][]interface{},
+	run func(context.Context, redis.Scripter, []string, ...interface{}) *redis.Cmd) ([]*redis.Cmd, error) {
+	pipe := w.client.Pipeline()
+	cmds := make([]*redis.Cmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = run(ctx, pipe, []string{key}, args[i]...)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		for _, cmd := range cmds {
+			// Errors other than script errors, ie. connection errors, are set on all commands.
+			if cmdErr := cmd.Err(); cmdErr != nil && !isRedisError(cmdErr) {
+				return nil, fmt.Errorf("failed to write features to redis: %v", cmdErr)
+			}
+		}
+	}
+	return cmds, nil
+}
+
+// Returns the arguments of the write script for a row: the timestamp field, the event timestamp in seconds, the
+// expiry of the key, and the fields to set.
+func (w *RedisWriter) scriptArgs(fv *feast.FeatureView, row *encodedRow) []interface{} {
+	// An empty expiry leaves the expiry of the key as is, and 0 removes it.
+	var expireAt, expireIn interface{} = "", ""
+	if w.keyExpiry {
+		expireAt, expireIn = 0, 0
+		if ttl := fv.TTL(); ttl > 0 {
+			expiry := time.Unix(row.eventSeconds, 0).Add(ttl)
+			expireAt, expireIn = expiry.Unix(), time.Until(expiry).Milliseconds()
+		}
+	}
+	args := []interface{}{redisTimestampField(fv.Name()), row.eventSeconds, expireAt, expireIn}
+	for field, value := range row.fields {
+		args = append(args, field, value)
+	}
+	return args
+}
+
+// Reports whether the error was returned by Redis rather than by the connection.
+func isRedisError(err error) bool {
+	_, ok := err.(redis.Error)
+	return ok && err != redis.Nil
+}
+
+// encodedRow holds the hash fields of a row to write, along with its event timestamp in seconds.
+type encodedRow struct {
+	fields       map[string]interface{}
+	eventSeconds int64
+}
+
+// Returns the hash fields holding the values and the event timestamp of a row.
+func encodeRedisRow(fv *feast.FeatureView, row FeatureRow) (map[string]interface{}, error) {
+	fields := make(map[string]interface{}, len(row.Values)+1)
+	for name, value := range row.Values {
+		data, err := proto.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[string(redisHashField(fv.Name(), name))] = data
+	}
+	data, err := proto.Marshal(&timestamppb.Timestamp{Seconds: row.EventTimestamp.Unix()})
+	if err != nil {
+		return nil, err
+	}
+	fields[redisTimestampField(fv.Name())] = data
+	return fields, nil
+}
@@ -0,0 +1,310 @@
+package onlinestore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/onlinestore/internal/lookup"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+type featureWrite struct {
+	featureView string
+	rows        []FeatureRow
+	want        int
+	wantErr     bool
+}
+
+func TestRedisWriterWrite(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	driver := func(id int64) feast.Row { return feast.Row{"driver_id": feast.Int64Val(id)} }
+
+	tt := []struct {
+		name       string
+		writes     []featureWrite
+		wantTrips  []*types.Value
+		wantRating []*types.Value
+	}{
+		{
+			name: "write rows",
+			writes: []featureWrite{
+				{featureView: "driver_stats", want: 2, rows: []FeatureRow{
+					{Entities: driver(1), EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.Int64Val(10), "rating": feast.DoubleVal(4.5)}},
+					{Entities: driver(2), EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.Int64Val(20)}},
+				}},
+			},
+			wantTrips:  []*types.Value{feast.Int64Val(10), feast.Int64Val(20)},
+			wantRating: []*types.Value{feast.DoubleVal(4.5), {}},
+		},
+		{
+			name: "only newer rows overwrite",
+			writes: []featureWrite{
+				{featureView: "driver_stats", want: 2, rows: []FeatureRow{
+					{Entities: driver(1), EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.Int64Val(10)}},
+					{Entities: driver(2), EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.Int64Val(20)}},
+				}},
+				{featureView: "driver_stats", want: 1, rows: []FeatureRow{
+					{Entities: driver(1), EventTimestamp: now.Add(-time.Minute), Values: map[string]*types.Value{"trips": feast.Int64Val(9)}},
+					{Entities: driver(2), EventTimestamp: now.Add(time.Second), Values: map[string]*types.Value{"trips": feast.Int64Val(21)}},
+				}},
+				{featureView: "driver_stats", want: 0, rows: []FeatureRow{
+					{Entities: driver(1), EventTimestamp: now.Add(time.Millisecond), Values: map[string]*types.Value{"trips": feast.Int64Val(11)}},
+				}},
+			},
+			wantTrips:  []*types.Value{feast.Int64Val(10), feast.Int64Val(21)},
+			wantRating: []*types.Value{{}, {}},
+		},
+		{
+			name: "most recent row of a batch",
+			writes: []featureWrite{
+				{featureView: "driver_stats", want: 1, rows: []FeatureRow{
+					{Entities: driver(1), EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.Int64Val(10)}},
+					{Entities: driver(1), EventTimestamp: now.Add(time.Minute), Values: map[string]*types.Value{"trips": feast.Int64Val(11)}},
+					{Entities: driver(1), EventTimestamp: now.Add(-time.Minute), Values: map[string]*types.Value{"trips": feast.Int64Val(9)}},
+				}},
+			},
+			wantTrips:  []*types.Value{feast.Int64Val(11), {}},
+			wantRating: []*types.Value{{}, {}},
+		},
+		{
+			name: "invalid rows",
+			writes: []featureWrite{
+				{featureView: "driver_stats", wantErr: true, rows: []FeatureRow{
+					{Entities: driver(1), EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.Int64Val(10)}},
+					{Entities: driver(2), EventTimestamp: now, Values: map[string]*types.Value{"speed": feast.DoubleVal(1)}},
+				}},
+				{featureView: "driver_stats", wantErr: true, rows: []FeatureRow{
+					{Entities: driver(1), EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.StrVal("ten")}},
+				}},
+				{featureView: "driver_stats", wantErr: true, rows: []FeatureRow{
+					{Entities: driver(1), Values: map[string]*types.Value{"trips": feast.Int64Val(10)}},
+				}},
+				{featureView: "driver_stats", wantErr: true, rows: []FeatureRow{
+					{Entities: feast.Row{}, EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.Int64Val(10)}},
+				}},
+				{featureView: "driver_stats", wantErr: true, rows: []FeatureRow{
+					{Entities: feast.Row{"driver_id": feast.DoubleVal(1)}, EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.Int64Val(10)}},
+				}},
+				{featureView: "rider_stats", wantErr: true, rows: []FeatureRow{
+					{Entities: driver(1), EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.Int64Val(10)}},
+				}},
+			},
+			wantTrips:  []*types.Value{{}, {}},
+			wantRating: []*types.Value{{}, {}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			server := miniredis.RunT(t)
+			client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+			writer := NewRedisWriter(client, "driver_project", testFeatureViews())
+			defer writer.Close()
+
+			for i, write := range tc.writes {
+				got, err := writer.Write(context.Background(), write.featureView, write.rows)
+				if (err != nil) != write.wantErr {
+					t.Fatalf("write %d: error = %v, wantErr %v", i, err, write.wantErr)
+				}
+				if got != write.want {
+					t.Errorf("write %d: wrote %d rows, want %d", i, got, write.want)
+				}
+			}
+
+			store := NewRedisOnlineStore(client, "driver_project", testFeatureViews())
+			store.now = func() time.Time { return now }
+			resp, err := store.GetOnlineFeatures(context.Background(), &feast.OnlineFeaturesRequest{
+				Features: []string{"driver_stats:trips", "driver_stats:rating"},
+				Entities: []feast.Row{driver(1), driver(2)},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantTrips, resp.RawResponse.Results[0].Values, protocmp.Transform()); diff != "" {
+				t.Errorf("trips differ (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRating, resp.RawResponse.Results[1].Values, protocmp.Transform()); diff != "" {
+				t.Errorf("ratings differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRedisWriterFormat(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	writer := NewRedisWriter(client, "driver_project", testFeatureViews())
+	defer writer.Close()
+
+	_, err := writer.Write(context.Background(), "driver_stats", []FeatureRow{{
+		Entities:       feast.Row{"driver_id": feast.Int64Val(1)},
+		Values:         map[string]*types.Value{"trips": feast.Int64Val(10)},
+		EventTimestamp: time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := append(append([]byte{2, 0, 0, 0}, "driver_id"...), 4, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0)
+	key = append(key, "driver_project"...)
+	want := map[string]string{
+		string(redisHashField("driver_stats", "trips")): string([]byte{0x20, 0x0a}),
+		"_ts:driver_stats": string([]byte{0x08, 0xc0, 0xc2, 0xd8, 0x85, 0x06}),
+	}
+	got, err := client.HGetAll(context.Background(), string(key)).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("hash differs (-want +got):\n%s", diff)
+	}
+}
+
+func TestRedisWriterKeyExpiry(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	writer := NewRedisWriter(client, "driver_project", testFeatureViews()).WithKeyExpiry(true)
+	defer writer.Close()
+
+	now := time.Now().Truncate(time.Second)
+	key, err := RedisKey(EntityKeyFormat, "driver_project", lookup.EntityKey(feast.Row{"driver_id": feast.Int64Val(1)}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt := []struct {
+		name        string
+		featureView string
+		values      map[string]*types.Value
+		timestamp   time.Time
+		want        time.Duration
+	}{
+		{
+			name:        "expires after the feature view ttl",
+			featureView: "driver_stats",
+			values:      map[string]*types.Value{"trips": feast.Int64Val(10)},
+			timestamp:   now.Add(-10 * time.Minute),
+			want:        50 * time.Minute,
+		},
+		{
+			name:        "extended by newer rows",
+			featureView: "driver_stats",
+			values:      map[string]*types.Value{"trips": feast.Int64Val(11)},
+			timestamp:   now,
+			want:        time.Hour,
+		},
+		{
+			name:        "not expiring with feature views without ttl",
+			featureView: "driver_profile",
+			values:      map[string]*types.Value{"name": feast.StrVal("alice")},
+			timestamp:   now,
+			want:        0,
+		},
+		{
+			name:        "not expiring once persisted",
+			featureView: "driver_stats",
+			values:      map[string]*types.Value{"trips": feast.Int64Val(12)},
+			timestamp:   now.Add(time.Second),
+			want:        0,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			rows := []FeatureRow{{Entities: feast.Row{"driver_id": feast.Int64Val(1)}, Values: tc.values, EventTimestamp: tc.timestamp}}
+			if _, err := writer.Write(context.Background(), tc.featureView, rows); err != nil {
+				t.Fatal(err)
+			}
+			got := server.TTL(string(key))
+			if got < tc.want-5*time.Second || got > tc.want {
+				t.Errorf("got ttl %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// concurrentWriteHook writes a row to Redis through another client before the first pipeline writing to Redis runs,
+// as another writer would after a writer read the event timestamp of the row.
+type concurrentWriteHook struct {
+	t    *testing.T
+	addr string
+	key  []byte
+	row  map[string]*types.Value
+	time time.Time
+	done bool
+}
+
+func (h *concurrentWriteHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	return ctx, nil
+}
+
+func (h *concurrentWriteHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	return nil
+}
+
+func (h *concurrentWriteHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	for _, cmd := range cmds {
+		switch cmd.Name() {
+		case "hset", "eval", "evalsha":
+			if !h.done {
+				h.done = true
+				other := redis.NewClient(&redis.Options{Addr: h.addr})
+				defer other.Close()
+				hsetRedisRow(h.t, other, h.key, "driver_stats", h.time, h.row)
+			}
+		}
+	}
+	return ctx, nil
+}
+
+func (h *concurrentWriteHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	return nil
+}
+
+func TestRedisWriterConcurrentWrite(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	writer := NewRedisWriter(client, "driver_project", testFeatureViews())
+	defer writer.Close()
+
+	driver := feast.Row{"driver_id": feast.Int64Val(1)}
+	key, err := RedisKey(EntityKeyFormat, "driver_project", lookup.EntityKey(driver))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The row is newer than the stored row when the writer starts, and older once the other writer wrote.
+	hsetRedisRow(t, client, key, "driver_stats", now.Add(-time.Hour), map[string]*types.Value{"trips": feast.Int64Val(1)})
+	client.AddHook(&concurrentWriteHook{
+		t:    t,
+		addr: server.Addr(),
+		key:  key,
+		row:  map[string]*types.Value{"trips": feast.Int64Val(3)},
+		time: now.Add(time.Minute),
+	})
+
+	rows := []FeatureRow{{Entities: driver, EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.Int64Val(2)}}}
+	written, err := writer.Write(context.Background(), "driver_stats", rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written != 0 {
+		t.Errorf("wrote %d rows, want 0", written)
+	}
+
+	store := NewRedisOnlineStore(client, "driver_project", testFeatureViews())
+	store.now = func() time.Time { return now }
+	resp, err := store.GetOnlineFeatures(context.Background(), &feast.OnlineFeaturesRequest{
+		Features: []string{"driver_stats:trips"},
+		Entities: []feast.Row{driver},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*types.Value{feast.Int64Val(3)}, resp.RawResponse.Results[0].Values, protocmp.Transform()); diff != "" {
+		t.Errorf("trips differ (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,262 @@
+// Package sqlite reads and writes feature values in the SQLite online store used by the Python SDK in local mode.
+// It is separate from package onlinestore as the SQLite driver requires cgo.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/onlinestore"
+	"github.com/feast-dev/feast/sdk/go/onlinestore/internal/lookup"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/golang/protobuf/proto"
+	// Registers the sqlite3 driver.
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OnlineStore reads and writes feature values in the SQLite online store used by the Python SDK in local mode.
+// The features of each feature view are stored in a table named "<project>_<feature view>", with a row per feature
+// of each entity row holding the entity key serialized with SerializeEntityKey, the feature name, the serialized
+// Value, and the event and created timestamps.
+type OnlineStore struct {
+	db           *sql.DB
+	path         string
+	project      string
+	featureViews onlinestore.FeatureViewSource
+	// Returns the current time, against which the TTL of feature views is evaluated.
+	now func() time.Time
+}
+
+var _ feast.Client = (*OnlineStore)(nil)
+
+// NewOnlineStore opens the SQLite online store at the given path, ie. data/online.db in a feature repo,
+// creating it if it does not exist. Features of the given project are read unless overridden by requests, and feature
+// views are looked up in the given source, ie. a *registry.Registry.
+func NewOnlineStore(path string, project string, featureViews onlinestore.FeatureViewSource) (*OnlineStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to open sqlite online store %s: %v", path, err)
+	}
+	return &OnlineStore{
+		db:           db,
+		path:         path,
+		project:      project,
+		featureViews: featureViews,
+		now:          time.Now,
+	}, nil
+}
+
+// Close closes the database.
+func (s *OnlineStore) Close() error {
+	return s.db.Close()
+}
+
+// GetFeastServingInfo returns an empty response, as Java serving does.
+func (s *OnlineStore) GetFeastServingInfo(ctx context.Context, in *serving.GetFeastServingInfoRequest) (*serving.GetFeastServingInfoResponse, error) {
+	return &serving.GetFeastServingInfoResponse{}, nil
+}
+
+// Table returns the infra object describing the table of the given feature view.
+func (s *OnlineStore) Table(featureView string) *core.SqliteTable {
+	path, err := filepath.Abs(s.path)
+	if err != nil {
+		path = s.path
+	}
+	return &core.SqliteTable{Path: path, Name: tableName(s.project, featureView)}
+}
+
+// CreateTable creates the table of the given feature view if it does not exist, as feast apply does.
+func (s *OnlineStore) CreateTable(ctx context.Context, featureView string) error {
+	table := tableName(s.project, featureView)
+	statements := []string{
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (entity_key BLOB, feature_name TEXT, value BLOB, event_ts timestamp, "+
+			"created_ts timestamp, PRIMARY KEY(entity_key, feature_name))", quoteIdentifier(table)),
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (entity_key)", quoteIdentifier(table+"_ek"), quoteIdentifier(table)),
+	}
+	for _, statement := range statements {
+		if _, err := s.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to create table %s: %v", table, err)
+		}
+	}
+	return nil
+}
+
+// DropTable drops the table of the given feature view if it exists.
+func (s *OnlineStore) DropTable(ctx context.Context, featureView string) error {
+	table := tableName(s.project, featureView)
+	if _, err := s.db.ExecContext(ctx, "DROP TABLE IF EXISTS "+quoteIdentifier(table)); err != nil {
+		return fmt.Errorf("failed to drop table %s: %v", table, err)
+	}
+	return nil
+}
+
+// Write writes the rows of the given feature view in a single transaction, returning the number of rows written.
+// As the Python SDK does, stored values are overwritten whatever their event timestamp.
+func (s *OnlineStore) Write(ctx context.Context, featureView string, rows []onlinestore.FeatureRow) (int, error) {
+	fv, err := s.featureViews.GetFeatureView(s.project, featureView)
+	if err != nil {
+		return 0, err
+	}
+	type encodedValue struct {
+		entityKey []byte
+		feature   string
+		value     []byte
+		row       onlinestore.FeatureRow
+	}
+	var values []encodedValue
+	for i, row := range rows {
+		if err := lookup.CheckRow(fv, row.Entities, row.Values, row.EventTimestamp); err != nil {
+			return 0, fmt.Errorf("invalid row %d: %v", i, err)
+		}
+		entityKey, err := onlinestore.SerializeEntityKey(lookup.EntityKey(row.Entities))
+		if err != nil {
+			return 0, fmt.Errorf("invalid row %d: %v", i, err)
+		}
+		for feature, value := range row.Values {
+			data, err := proto.Marshal(value)
+			if err != nil {
+				return 0, err
+			}
+			values = append(values, encodedValue{entityKey: entityKey, feature: feature, value: data, row: row})
+		}
+	}
+
+	table := tableName(s.project, featureView)
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	stmt, err := tx.PrepareContext(ctx, fmt.Sprintf("INSERT INTO %s (entity_key, feature_name, value, event_ts, created_ts) "+
+		"VALUES (?, ?, ?, ?, ?) ON CONFLICT (entity_key, feature_name) DO UPDATE SET "+
+		"value = excluded.value, event_ts = excluded.event_ts, created_ts = excluded.created_ts", quoteIdentifier(table)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to write features to table %s: %v", table, err)
+	}
+	defer stmt.Close()
+	for _, value := range values {
+		_, err := stmt.ExecContext(ctx, value.entityKey, value.feature, value.value,
+			timestamp(value.row.EventTimestamp), timestamp(value.row.CreatedTimestamp))
+		if err != nil {
+			return 0, fmt.Errorf("failed to write features to table %s: %v", table, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to write features to table %s: %v", table, err)
+	}
+	return len(rows), nil
+}
+
+// GetOnlineFeatures reads the requested features of each entity row from the tables of their feature views,
+// returning them as Feast serving does. Entity rows are looked up by all the entities they hold. Features that are
+// not stored, or stored with a type other than the type of the feature, are NOT_FOUND, and features older than the
+// TTL of their feature view are OUTSIDE_MAX_AGE.
+func (s *OnlineStore) GetOnlineFeatures(ctx context.Context, req *feast.OnlineFeaturesRequest) (*feast.OnlineFeaturesResponse, error) {
+	resolved, err := lookup.Resolve(req, s.project, s.featureViews)
+	if err != nil {
+		return nil, err
+	}
+
+	entityKeys := make([]interface{}, len(resolved.EntityRows))
+	rowIndices := make(map[string][]int)
+	for i, row := range resolved.EntityRows {
+		entityKey, err := onlinestore.SerializeEntityKey(lookup.EntityKey(row))
+		if err != nil {
+			return nil, err
+		}
+		entityKeys[i] = entityKey
+		rowIndices[string(entityKey)] = append(rowIndices[string(entityKey)], i)
+	}
+
+	features := make([][]*lookup.Feature, len(resolved.EntityRows))
+	for i := range features {
+		features[i] = make([]*lookup.Feature, len(resolved.Refs))
+	}
+	for _, featureView := range resolved.FeatureViews {
+		// Indices of the requested features of the feature view, by name.
+		refIndices := make(map[string][]int)
+		for j, ref := range resolved.Refs {
+			if ref.FeatureView.Name() == featureView {
+				refIndices[ref.Feature.Name] = append(refIndices[ref.Feature.Name], j)
+			}
+		}
+		table := tableName(resolved.Project, featureView)
+		query := fmt.Sprintf("SELECT entity_key, feature_name, value, event_ts FROM %s WHERE entity_key IN (?%s)",
+			quoteIdentifier(table), strings.Repeat(", ?", len(entityKeys)-1))
+		rows, err := s.db.QueryContext(ctx, query, entityKeys...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read features from table %s: %v", table, err)
+		}
+		err = scanFeatures(rows, func(entityKey []byte, feature string, value *lookup.Feature) {
+			for _, i := range rowIndices[string(entityKey)] {
+				for _, j := range refIndices[feature] {
+					features[i][j] = value
+				}
+			}
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read features from table %s: %v", table, err)
+		}
+	}
+	return resolved.Response(features, s.now()), nil
+}
+
+// Scans rows of entity keys, feature names, values and event timestamps, closing them.
+func scanFeatures(rows *sql.Rows, f func(entityKey []byte, feature string, value *lookup.Feature)) error {
+	defer rows.Close()
+	for rows.Next() {
+		var entityKey, data []byte
+		var feature string
+		var eventTimestamp sql.NullTime
+		if err := rows.Scan(&entityKey, &feature, &data, &eventTimestamp); err != nil {
+			return err
+		}
+		value := &types.Value{}
+		if err := proto.Unmarshal(data, value); err != nil {
+			return fmt.Errorf("failed to decode value of feature %s: %v", feature, err)
+		}
+		stored := &lookup.Feature{Value: value, EventTimestamp: &timestamppb.Timestamp{}}
+		if eventTimestamp.Valid {
+			stored.EventTimestamp = timestamppb.New(eventTimestamp.Time)
+		}
+		f(entityKey, feature, stored)
+	}
+	return rows.Err()
+}
+
+// Returns the name of the table of a feature view.
+func tableName(project string, featureView string) string {
+	return project + "_" + featureView
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// Returns the timestamp as stored by Python's sqlite3 module, in naive UTC with microseconds if any, or nil if zero.
+func timestamp(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC().Truncate(time.Microsecond)
+	if t.Nanosecond() == 0 {
+		return t.Format("2006-01-02 15:04:05")
+	}
+	return t.Format("2006-01-02 15:04:05.000000")
+}
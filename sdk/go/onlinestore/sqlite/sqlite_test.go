@@ -0,0 +1,264 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/onlinestore"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// featureViewMap is a FeatureViewSource holding the feature views of a single project by name.
+type featureViewMap map[string]*feast.FeatureView
+
+func (m featureViewMap) GetFeatureView(project string, name string) (*feast.FeatureView, error) {
+	featureView, ok := m[name]
+	if !ok {
+		return nil, fmt.Errorf("feature view %s does not exist in project %s", name, project)
+	}
+	return featureView, nil
+}
+
+func testFeatureViews() featureViewMap {
+	source := feast.FileSource{Path: "driver_stats.parquet", EventTimestampColumn: "event_timestamp"}
+	return featureViewMap{
+		"driver_stats": feast.NewFeatureView("driver_stats", []string{"driver"}, source,
+			feast.Feature{Name: "rating", ValueType: types.ValueType_DOUBLE},
+			feast.Feature{Name: "trips", ValueType: types.ValueType_INT64},
+		).WithTTL(time.Hour),
+		"driver_profile": feast.NewFeatureView("driver_profile", []string{"driver"}, source,
+			feast.Feature{Name: "name", ValueType: types.ValueType_STRING},
+		),
+	}
+}
+
+type featureWrite struct {
+	featureView string
+	rows        []onlinestore.FeatureRow
+	want        int
+	wantErr     bool
+}
+
+func TestOnlineStore(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "data", "online.db")
+	store, err := NewOnlineStore(path, "driver_project", testFeatureViews())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.now = func() time.Time { return now }
+	defer store.Close()
+
+	ctx := context.Background()
+	for _, featureView := range []string{"driver_stats", "driver_profile"} {
+		if err := store.CreateTable(ctx, featureView); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writes := []featureWrite{
+		{featureView: "driver_stats", want: 3, rows: []onlinestore.FeatureRow{
+			{Entities: feast.Row{"driver_id": feast.Int64Val(1)}, EventTimestamp: now.Add(time.Minute), Values: map[string]*types.Value{"rating": feast.DoubleVal(1)}},
+			{Entities: feast.Row{"driver_id": feast.Int64Val(2)}, EventTimestamp: now.Add(-time.Minute), Values: map[string]*types.Value{"rating": {}, "trips": feast.Int64Val(20)}},
+			{Entities: feast.Row{"driver_id": feast.Int64Val(3)}, EventTimestamp: now.Add(-2 * time.Hour), Values: map[string]*types.Value{"rating": feast.DoubleVal(3.5)}},
+		}},
+		// Values are overwritten whatever their event timestamp, as the Python SDK does.
+		{featureView: "driver_stats", want: 1, rows: []onlinestore.FeatureRow{
+			{Entities: feast.Row{"driver_id": feast.Int64Val(1)}, EventTimestamp: now.Add(-time.Minute), Values: map[string]*types.Value{"rating": feast.DoubleVal(4.5), "trips": feast.Int64Val(10)}},
+		}},
+		{featureView: "driver_profile", want: 1, rows: []onlinestore.FeatureRow{
+			{Entities: feast.Row{"driver_id": feast.Int64Val(3)}, EventTimestamp: now.Add(-48 * time.Hour), Values: map[string]*types.Value{"name": feast.StrVal("alice")}},
+		}},
+		{featureView: "driver_stats", wantErr: true, rows: []onlinestore.FeatureRow{
+			{Entities: feast.Row{"driver_id": feast.Int64Val(1)}, EventTimestamp: now, Values: map[string]*types.Value{"trips": feast.StrVal("ten")}},
+		}},
+	}
+	for i, write := range writes {
+		got, err := store.Write(ctx, write.featureView, write.rows)
+		if (err != nil) != write.wantErr {
+			t.Fatalf("write %d: error = %v, wantErr %v", i, err, write.wantErr)
+		}
+		if got != write.want {
+			t.Errorf("write %d: wrote %d rows, want %d", i, got, write.want)
+		}
+	}
+
+	tt := []struct {
+		name    string
+		req     feast.OnlineFeaturesRequest
+		want    *serving.GetOnlineFeaturesResponse
+		wantErr bool
+	}{
+		{
+			name: "statuses",
+			req: feast.OnlineFeaturesRequest{
+				Features: []string{"driver_stats:rating", "driver_stats:trips", "driver_profile:name"},
+				Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}, {"driver_id": feast.Int64Val(2)}, {"driver_id": feast.Int64Val(3)}},
+			},
+			want: &serving.GetOnlineFeaturesResponse{
+				Metadata: &serving.GetOnlineFeaturesResponseMetadata{
+					FeatureNames: &serving.FeatureList{Val: []string{"driver_stats:rating", "driver_stats:trips", "driver_profile:name"}},
+				},
+				Results: []*serving.GetOnlineFeaturesResponse_FeatureVector{
+					{
+						Values:          []*types.Value{feast.DoubleVal(4.5), {}, feast.DoubleVal(3.5)},
+						Statuses:        []serving.FieldStatus{serving.FieldStatus_PRESENT, serving.FieldStatus_NULL_VALUE, serving.FieldStatus_OUTSIDE_MAX_AGE},
+						EventTimestamps: []*timestamppb.Timestamp{timestamppb.New(now.Add(-time.Minute)), timestamppb.New(now.Add(-time.Minute)), timestamppb.New(now.Add(-2 * time.Hour))},
+					},
+					{
+						Values:          []*types.Value{feast.Int64Val(10), feast.Int64Val(20), {}},
+						Statuses:        []serving.FieldStatus{serving.FieldStatus_PRESENT, serving.FieldStatus_PRESENT, serving.FieldStatus_NOT_FOUND},
+						EventTimestamps: []*timestamppb.Timestamp{timestamppb.New(now.Add(-time.Minute)), timestamppb.New(now.Add(-time.Minute)), {}},
+					},
+					{
+						Values:          []*types.Value{{}, {}, feast.StrVal("alice")},
+						Statuses:        []serving.FieldStatus{serving.FieldStatus_NOT_FOUND, serving.FieldStatus_NOT_FOUND, serving.FieldStatus_PRESENT},
+						EventTimestamps: []*timestamppb.Timestamp{{}, {}, timestamppb.New(now.Add(-48 * time.Hour))},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate entity rows",
+			req: feast.OnlineFeaturesRequest{
+				Features: []string{"driver_stats:trips"},
+				Entities: []feast.Row{{"driver_id": feast.Int64Val(2)}, {"driver_id": feast.Int64Val(2)}},
+			},
+			want: &serving.GetOnlineFeaturesResponse{
+				Metadata: &serving.GetOnlineFeaturesResponseMetadata{
+					FeatureNames: &serving.FeatureList{Val: []string{"driver_stats:trips"}},
+				},
+				Results: []*serving.GetOnlineFeaturesResponse_FeatureVector{
+					{
+						Values:          []*types.Value{feast.Int64Val(20), feast.Int64Val(20)},
+						Statuses:        []serving.FieldStatus{serving.FieldStatus_PRESENT, serving.FieldStatus_PRESENT},
+						EventTimestamps: []*timestamppb.Timestamp{timestamppb.New(now.Add(-time.Minute)), timestamppb.New(now.Add(-time.Minute))},
+					},
+				},
+			},
+		},
+		{
+			name: "missing table",
+			req: feast.OnlineFeaturesRequest{
+				Features: []string{"driver_stats:trips"},
+				Entities: []feast.Row{{"driver_id": feast.Int64Val(2)}},
+				Project:  "other_project",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := store.GetOnlineFeatures(ctx, &tc.req)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got.RawResponse, protocmp.Transform()); diff != "" {
+				t.Errorf("response differs (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if err := store.DropTable(ctx, "driver_profile"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = store.GetOnlineFeatures(ctx, &feast.OnlineFeaturesRequest{
+		Features: []string{"driver_profile:name"},
+		Entities: []feast.Row{{"driver_id": feast.Int64Val(3)}},
+	})
+	if err == nil {
+		t.Errorf("got no error reading a dropped table")
+	}
+}
+
+func TestOnlineStoreFormat(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "online.db")
+	store, err := NewOnlineStore(path, "driver_project", testFeatureViews())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.now = func() time.Time { return now }
+	defer store.Close()
+
+	ctx := context.Background()
+	if err := store.CreateTable(ctx, "driver_stats"); err != nil {
+		t.Fatal(err)
+	}
+	wantTable := &core.SqliteTable{Path: path, Name: "driver_project_driver_stats"}
+	if diff := cmp.Diff(wantTable, store.Table("driver_stats"), protocmp.Transform()); diff != "" {
+		t.Errorf("table differs (-want +got):\n%s", diff)
+	}
+	_, err = store.Write(ctx, "driver_stats", []onlinestore.FeatureRow{{
+		Entities:         feast.Row{"driver_id": feast.Int64Val(1)},
+		Values:           map[string]*types.Value{"trips": feast.Int64Val(10)},
+		EventTimestamp:   now,
+		CreatedTimestamp: now.Add(1500 * time.Millisecond),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rows are read back as stored by Python's sqlite3 module.
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	var entityKey, value []byte
+	var feature, eventTimestamp, createdTimestamp string
+	err = db.QueryRow(`SELECT entity_key, feature_name, value, CAST(event_ts AS TEXT), CAST(created_ts AS TEXT) FROM driver_project_driver_stats`).
+		Scan(&entityKey, &feature, &value, &eventTimestamp, &createdTimestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []interface{}{entityKey, feature, value, eventTimestamp, createdTimestamp}
+	want := []interface{}{
+		append(append([]byte{2, 0, 0, 0}, "driver_id"...), 4, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0),
+		"trips",
+		[]byte{0x20, 0x0a},
+		"2021-06-01 12:00:00",
+		"2021-06-01 12:00:01.500000",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("row differs (-want +got):\n%s", diff)
+	}
+
+	// Rows written by Python's sqlite3 module are read.
+	data, err := proto.Marshal(feast.DoubleVal(4.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(`INSERT INTO driver_project_driver_stats VALUES (?, 'rating', ?, '2021-06-01 11:59:30.250000', NULL)`, entityKey, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := store.GetOnlineFeatures(ctx, &feast.OnlineFeaturesRequest{
+		Features: []string{"driver_stats:rating"},
+		Entities: []feast.Row{{"driver_id": feast.Int64Val(1)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantVector := &serving.GetOnlineFeaturesResponse_FeatureVector{
+		Values:          []*types.Value{feast.DoubleVal(4.5)},
+		Statuses:        []serving.FieldStatus{serving.FieldStatus_PRESENT},
+		EventTimestamps: []*timestamppb.Timestamp{timestamppb.New(now.Add(-29750 * time.Millisecond))},
+	}
+	if diff := cmp.Diff(wantVector, resp.RawResponse.Results[0], protocmp.Transform()); diff != "" {
+		t.Errorf("features differ (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,79 @@
+package policy
+
+import (
+	"context"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/auth"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FeatureServiceResolver returns the feature references of the feature service with the given name.
+type FeatureServiceResolver func(ctx context.Context, name string) ([]string, error)
+
+// Enforcer enforces a Policy on the requests to a Feast project.
+type Enforcer struct {
+	// Policy to enforce.
+	Policy *Policy
+	// Project requests are authorized for, unless the request overrides it.
+	Project string
+	// Optional: Resolves the features of requested feature services.
+	// Requests for feature services are denied if unspecified.
+	ResolveFeatureService FeatureServiceResolver
+}
+
+// UnaryServerInterceptor returns a gRPC unary server interceptor authorizing online feature requests
+// for the principal identified by the verified claims on the context.
+// Must be chained after the auth package's interceptor, which puts the verified claims on the context.
+func (e *Enforcer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		featuresReq, ok := req.(*serving.GetOnlineFeaturesRequest)
+		if !ok {
+			return handler(ctx, req)
+		}
+		claims, ok := auth.ClaimsFromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "request is not authenticated")
+		}
+		featureRefs, err := e.featureRefs(ctx, featuresReq)
+		if err != nil {
+			return nil, err
+		}
+		if err := e.Policy.Authorize(e.Policy.PrincipalFromClaims(claims), e.Project, ActionRead, featureRefs); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ClientAuthorizer returns a feast.RequestAuthorizer checking online feature requests client side
+// for the given principal before they are sent, so that forbidden requests fail early.
+func (e *Enforcer) ClientAuthorizer(principal Principal) feast.RequestAuthorizer {
+	return func(ctx context.Context, req *feast.OnlineFeaturesRequest) error {
+		project := e.Project
+		if req.Project != "" {
+			project = req.Project
+		}
+		return e.Policy.Authorize(principal, project, ActionRead, req.Features)
+	}
+}
+
+// Returns the feature references requested by the given request.
+func (e *Enforcer) featureRefs(ctx context.Context, req *serving.GetOnlineFeaturesRequest) ([]string, error) {
+	featureService := req.GetFeatureService()
+	if featureService == "" {
+		return req.GetFeatures().GetVal(), nil
+	}
+	if e.ResolveFeatureService == nil {
+		return nil, status.Errorf(codes.PermissionDenied,
+			"feature service %s cannot be authorized as feature services are not resolvable", featureService)
+	}
+	featureRefs, err := e.ResolveFeatureService(ctx, featureService)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to resolve feature service %s: %v", featureService, err)
+	}
+	return featureRefs, nil
+}
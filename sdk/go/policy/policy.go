@@ -0,0 +1,235 @@
+// Package policy implements project and feature view scoped authorization of Feast requests.
+package policy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/feast-dev/feast/sdk/go/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+// Action is an action performed on features.
+type Action string
+
+const (
+	// ActionRead reads feature values, ie. online or historical retrieval.
+	ActionRead Action = "read"
+	// ActionWrite writes feature values, ie. materialization or push.
+	ActionWrite Action = "write"
+)
+
+// Effect is the effect of a rule matching a request.
+type Effect string
+
+const (
+	// Allow permits matching requests unless another matching rule denies them.
+	Allow Effect = "allow"
+	// Deny forbids matching requests, taking precedence over allow rules.
+	Deny Effect = "deny"
+)
+
+// Default name of the token claim holding the groups of a principal.
+const defaultGroupsClaim = "groups"
+
+// Policy is a declarative set of rules deciding which principals may perform which actions
+// on which feature views. Features not allowed by any rule are denied.
+type Policy struct {
+	// Name of the token claim holding the groups of a principal. Defaults to "groups".
+	GroupsClaim string `yaml:"groups_claim"`
+	// Rules of the policy.
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule matches principals by subject or group and features by project, feature view and action.
+// Projects and feature views are matched with glob patterns as supported by path.Match.
+// Empty project, feature view and action lists match anything.
+type Rule struct {
+	// Name of the rule, used in error messages.
+	Name string `yaml:"name"`
+	// Effect of the rule when it matches. Defaults to allow.
+	Effect Effect `yaml:"effect"`
+	// Subjects matched by the rule. "*" matches any subject.
+	Subjects []string `yaml:"subjects"`
+	// Groups matched by the rule.
+	Groups []string `yaml:"groups"`
+	// Project glob patterns matched by the rule.
+	Projects []string `yaml:"projects"`
+	// Feature view glob patterns matched by the rule.
+	FeatureViews []string `yaml:"feature_views"`
+	// Actions matched by the rule.
+	Actions []Action `yaml:"actions"`
+}
+
+// Principal is the identity a request is authorized for.
+type Principal struct {
+	Subject string
+	Groups  []string
+}
+
+// LoadPolicy reads and parses the YAML policy at the given path.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePolicy(data)
+}
+
+// ParsePolicy parses and validates a YAML policy.
+func ParsePolicy(data []byte) (*Policy, error) {
+	policy := &Policy{}
+	decoder := yaml.NewDecoder(strings.NewReader(string(data)))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(policy); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %v", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Validate checks that the rules of the policy are well formed.
+func (p *Policy) Validate() error {
+	for i, rule := range p.Rules {
+		name := rule.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i)
+		}
+		if rule.Effect != "" && rule.Effect != Allow && rule.Effect != Deny {
+			return fmt.Errorf("rule %s has invalid effect '%s'", name, rule.Effect)
+		}
+		if len(rule.Subjects) == 0 && len(rule.Groups) == 0 {
+			return fmt.Errorf("rule %s must match at least one subject or group", name)
+		}
+		for _, pattern := range append(append([]string{}, rule.Projects...), rule.FeatureViews...) {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("rule %s has invalid pattern '%s': %v", name, pattern, err)
+			}
+		}
+		for _, action := range rule.Actions {
+			if action != ActionRead && action != ActionWrite && action != "*" {
+				return fmt.Errorf("rule %s has invalid action '%s'", name, action)
+			}
+		}
+	}
+	return nil
+}
+
+// PrincipalFromClaims builds the principal identified by the verified claims of a token.
+func (p *Policy) PrincipalFromClaims(claims *auth.Claims) Principal {
+	groupsClaim := p.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = defaultGroupsClaim
+	}
+	return Principal{Subject: claims.Subject, Groups: claims.Strings(groupsClaim)}
+}
+
+// Authorize checks whether the principal may perform the action on the given features of the project.
+// Feature references are given in the format feature_view:feature.
+// Returns a *DeniedError listing the forbidden feature references if any are not allowed.
+func (p *Policy) Authorize(principal Principal, project string, action Action, featureRefs []string) error {
+	var forbidden []string
+	for _, ref := range featureRefs {
+		featureView := ref
+		if idx := strings.Index(ref, ":"); idx >= 0 {
+			featureView = ref[:idx]
+		}
+		if !p.allowed(principal, project, featureView, action) {
+			forbidden = append(forbidden, ref)
+		}
+	}
+	if len(forbidden) > 0 {
+		sort.Strings(forbidden)
+		return &DeniedError{Principal: principal, Project: project, Action: action, FeatureRefs: forbidden}
+	}
+	return nil
+}
+
+// Checks whether the principal may perform the action on the feature view.
+// Deny rules take precedence over allow rules.
+func (p *Policy) allowed(principal Principal, project string, featureView string, action Action) bool {
+	allowed := false
+	for _, rule := range p.Rules {
+		if !rule.matches(principal, project, featureView, action) {
+			continue
+		}
+		if rule.Effect == Deny {
+			return false
+		}
+		allowed = true
+	}
+	return allowed
+}
+
+func (r Rule) matches(principal Principal, project string, featureView string, action Action) bool {
+	return r.matchesPrincipal(principal) &&
+		matchesAny(r.Projects, project) &&
+		matchesAny(r.FeatureViews, featureView) &&
+		r.matchesAction(action)
+}
+
+func (r Rule) matchesPrincipal(principal Principal) bool {
+	for _, subject := range r.Subjects {
+		if subject == "*" || subject == principal.Subject {
+			return true
+		}
+	}
+	for _, group := range r.Groups {
+		for _, principalGroup := range principal.Groups {
+			if group == principalGroup {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r Rule) matchesAction(action Action) bool {
+	if len(r.Actions) == 0 {
+		return true
+	}
+	for _, a := range r.Actions {
+		if a == "*" || a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// Checks whether the value matches any of the glob patterns, or if there are no patterns.
+func matchesAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// DeniedError is returned when a principal is not allowed to access some of the requested features.
+type DeniedError struct {
+	Principal   Principal
+	Project     string
+	Action      Action
+	FeatureRefs []string
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("%s is not allowed to %s features in project %s: %s",
+		e.Principal.Subject, e.Action, e.Project, strings.Join(e.FeatureRefs, ", "))
+}
+
+// GRPCStatus returns the PermissionDenied status of the error.
+func (e *DeniedError) GRPCStatus() *status.Status {
+	return status.New(codes.PermissionDenied, e.Error())
+}
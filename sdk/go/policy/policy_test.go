@@ -0,0 +1,219 @@
+package policy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/auth"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testPolicy = `
+groups_claim: teams
+rules:
+  - name: team-a-reads-own-views
+    subjects: ["team-a-service"]
+    projects: ["driver_*"]
+    feature_views: ["driver_*", "pii_driver"]
+    actions: [read]
+  - name: team-b-reads-everything
+    groups: ["team-b"]
+  - name: nobody-reads-pii-from-team-b
+    effect: deny
+    groups: ["team-b"]
+    feature_views: ["pii_*"]
+  - name: ingestion-writes
+    subjects: ["ingestion"]
+    actions: [write]
+`
+
+func TestParsePolicy(t *testing.T) {
+	tt := []struct {
+		name    string
+		policy  string
+		wantErr bool
+	}{
+		{name: "Valid policy", policy: testPolicy},
+		{name: "Unknown field", policy: "rules:\n  - subjects: [a]\n    feature_view: [b]\n", wantErr: true},
+		{name: "Invalid effect", policy: "rules:\n  - subjects: [a]\n    effect: maybe\n", wantErr: true},
+		{name: "Rule without principal", policy: "rules:\n  - projects: [a]\n", wantErr: true},
+		{name: "Invalid pattern", policy: "rules:\n  - subjects: [a]\n    feature_views: ['[']\n", wantErr: true},
+		{name: "Invalid action", policy: "rules:\n  - subjects: [a]\n    actions: [delete]\n", wantErr: true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParsePolicy([]byte(tc.policy))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	policy, err := ParsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt := []struct {
+		name          string
+		principal     Principal
+		project       string
+		action        Action
+		featureRefs   []string
+		wantForbidden []string
+	}{
+		{
+			name:        "Allowed by subject",
+			principal:   Principal{Subject: "team-a-service"},
+			project:     "driver_project",
+			action:      ActionRead,
+			featureRefs: []string{"driver_stats:rating", "pii_driver:name"},
+		},
+		{
+			name:          "Feature view not allowed",
+			principal:     Principal{Subject: "team-a-service"},
+			project:       "driver_project",
+			action:        ActionRead,
+			featureRefs:   []string{"driver_stats:rating", "pii_customer:name", "customer_stats:orders"},
+			wantForbidden: []string{"customer_stats:orders", "pii_customer:name"},
+		},
+		{
+			name:          "Project not allowed",
+			principal:     Principal{Subject: "team-a-service"},
+			project:       "customer_project",
+			action:        ActionRead,
+			featureRefs:   []string{"driver_stats:rating"},
+			wantForbidden: []string{"driver_stats:rating"},
+		},
+		{
+			name:          "Action not allowed",
+			principal:     Principal{Subject: "team-a-service"},
+			project:       "driver_project",
+			action:        ActionWrite,
+			featureRefs:   []string{"driver_stats:rating"},
+			wantForbidden: []string{"driver_stats:rating"},
+		},
+		{
+			name:          "Deny takes precedence over allow",
+			principal:     Principal{Subject: "someone", Groups: []string{"team-b"}},
+			project:       "driver_project",
+			action:        ActionRead,
+			featureRefs:   []string{"driver_stats:rating", "pii_driver:name"},
+			wantForbidden: []string{"pii_driver:name"},
+		},
+		{
+			name:          "Denied by default",
+			principal:     Principal{Subject: "unknown"},
+			project:       "driver_project",
+			action:        ActionRead,
+			featureRefs:   []string{"driver_stats:rating"},
+			wantForbidden: []string{"driver_stats:rating"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Authorize(tc.principal, tc.project, tc.action, tc.featureRefs)
+			if tc.wantForbidden == nil {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			denied, ok := err.(*DeniedError)
+			if !ok {
+				t.Fatalf("Expected DeniedError, got %v", err)
+			}
+			if !reflect.DeepEqual(denied.FeatureRefs, tc.wantForbidden) {
+				t.Errorf("Expected forbidden features %v, got %v", tc.wantForbidden, denied.FeatureRefs)
+			}
+			if status.Code(err) != codes.PermissionDenied {
+				t.Errorf("Expected PermissionDenied status, got %v", status.Code(err))
+			}
+		})
+	}
+}
+
+func TestEnforcer(t *testing.T) {
+	policy, err := ParsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer := &Enforcer{
+		Policy:  policy,
+		Project: "driver_project",
+		ResolveFeatureService: func(ctx context.Context, name string) ([]string, error) {
+			return []string{"driver_stats:rating", "pii_driver:name"}, nil
+		},
+	}
+	teamB := &auth.Claims{Subject: "someone", Raw: map[string]interface{}{"teams": []interface{}{"team-b"}}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	tt := []struct {
+		name     string
+		claims   *auth.Claims
+		req      interface{}
+		wantCode codes.Code
+	}{
+		{
+			name:   "Allowed features",
+			claims: teamB,
+			req: &serving.GetOnlineFeaturesRequest{Kind: &serving.GetOnlineFeaturesRequest_Features{
+				Features: &serving.FeatureList{Val: []string{"driver_stats:rating"}},
+			}},
+			wantCode: codes.OK,
+		},
+		{
+			name:   "Forbidden features",
+			claims: teamB,
+			req: &serving.GetOnlineFeaturesRequest{Kind: &serving.GetOnlineFeaturesRequest_Features{
+				Features: &serving.FeatureList{Val: []string{"pii_driver:name"}},
+			}},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Forbidden feature service",
+			claims:   teamB,
+			req:      &serving.GetOnlineFeaturesRequest{Kind: &serving.GetOnlineFeaturesRequest_FeatureService{FeatureService: "driver_service"}},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Unauthenticated request",
+			req:      &serving.GetOnlineFeaturesRequest{},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Other requests pass through",
+			req:      &serving.GetFeastServingInfoRequest{},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.claims != nil {
+				ctx = auth.NewContextWithClaims(ctx, tc.claims)
+			}
+			_, err := enforcer.UnaryServerInterceptor()(ctx, tc.req, &grpc.UnaryServerInfo{}, handler)
+			if got := status.Code(err); got != tc.wantCode {
+				t.Errorf("Expected status code %v, got %v: %v", tc.wantCode, got, err)
+			}
+		})
+	}
+
+	// Client side checks use the request's project override.
+	authorize := enforcer.ClientAuthorizer(Principal{Subject: "team-a-service"})
+	req := &feast.OnlineFeaturesRequest{Features: []string{"driver_stats:rating"}}
+	if err := authorize(context.Background(), req); err != nil {
+		t.Errorf("Unexpected error authorizing client request: %v", err)
+	}
+	req.Project = "customer_project"
+	if err := authorize(context.Background(), req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected client request for other project to be denied, got %v", err)
+	}
+}
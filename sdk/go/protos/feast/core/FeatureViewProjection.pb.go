@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.4
+// source: feast/core/FeatureViewProjection.proto
+
+package core
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A projection to be applied on top of a FeatureView.
+// Contains the modifications to a FeatureView such as the features subset to use.
+type FeatureViewProjection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The feature view name
+	FeatureViewName string `protobuf:"bytes,1,opt,name=feature_view_name,json=featureViewName,proto3" json:"feature_view_name,omitempty"`
+	// Alias for feature view name
+	FeatureViewNameAlias string `protobuf:"bytes,3,opt,name=feature_view_name_alias,json=featureViewNameAlias,proto3" json:"feature_view_name_alias,omitempty"`
+	// The features of the feature view that are a part of the feature reference.
+	FeatureColumns []*FeatureSpecV2 `protobuf:"bytes,2,rep,name=feature_columns,json=featureColumns,proto3" json:"feature_columns,omitempty"`
+	// Map for entity join_key overrides of feature data entity join_key to entity data join_key
+	JoinKeyMap map[string]string `protobuf:"bytes,4,rep,name=join_key_map,json=joinKeyMap,proto3" json:"join_key_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *FeatureViewProjection) Reset() {
+	*x = FeatureViewProjection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feast_core_FeatureViewProjection_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeatureViewProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureViewProjection) ProtoMessage() {}
+
+func (x *FeatureViewProjection) ProtoReflect() protoreflect.Message {
+	mi := &file_feast_core_FeatureViewProjection_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureViewProjection.ProtoReflect.Descriptor instead.
+func (*FeatureViewProjection) Descriptor() ([]byte, []int) {
+	return file_feast_core_FeatureViewProjection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeatureViewProjection) GetFeatureViewName() string {
+	if x != nil {
+		return x.FeatureViewName
+	}
+	return ""
+}
+
+func (x *FeatureViewProjection) GetFeatureViewNameAlias() string {
+	if x != nil {
+		return x.FeatureViewNameAlias
+	}
+	return ""
+}
+
+func (x *FeatureViewProjection) GetFeatureColumns() []*FeatureSpecV2 {
+	if x != nil {
+		return x.FeatureColumns
+	}
+	return nil
+}
+
+func (x *FeatureViewProjection) GetJoinKeyMap() map[string]string {
+	if x != nil {
+		return x.JoinKeyMap
+	}
+	return nil
+}
+
+var File_feast_core_FeatureViewProjection_proto protoreflect.FileDescriptor
+
+var file_feast_core_FeatureViewProjection_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x1a, 0x18, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2,
+	0x02, 0x0a, 0x15, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x69, 0x65, 0x77,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x69,
+	0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x66,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x70, 0x65, 0x63, 0x56, 0x32, 0x52,
+	0x0e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12,
+	0x53, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6d, 0x61, 0x70, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4b, 0x65, 0x79,
+	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x4b, 0x65,
+	0x79, 0x4d, 0x61, 0x70, 0x1a, 0x3d, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x5e, 0x0a, 0x10, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x15, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x33,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x65, 0x61, 0x73, 0x74,
+	0x2d, 0x64, 0x65, 0x76, 0x2f, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x73, 0x64, 0x6b, 0x2f, 0x67,
+	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_feast_core_FeatureViewProjection_proto_rawDescOnce sync.Once
+	file_feast_core_FeatureViewProjection_proto_rawDescData = file_feast_core_FeatureViewProjection_proto_rawDesc
+)
+
+func file_feast_core_FeatureViewProjection_proto_rawDescGZIP() []byte {
+	file_feast_core_FeatureViewProjection_proto_rawDescOnce.Do(func() {
+		file_feast_core_FeatureViewProjection_proto_rawDescData = protoimpl.X.CompressGZIP(file_feast_core_FeatureViewProjection_proto_rawDescData)
+	})
+	return file_feast_core_FeatureViewProjection_proto_rawDescData
+}
+
+var file_feast_core_FeatureViewProjection_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_feast_core_FeatureViewProjection_proto_goTypes = []interface{}{
+	(*FeatureViewProjection)(nil), // 0: feast.core.FeatureViewProjection
+	nil,                           // 1: feast.core.FeatureViewProjection.JoinKeyMapEntry
+	(*FeatureSpecV2)(nil),         // 2: feast.core.FeatureSpecV2
+}
+var file_feast_core_FeatureViewProjection_proto_depIdxs = []int32{
+	2, // 0: feast.core.FeatureViewProjection.feature_columns:type_name -> feast.core.FeatureSpecV2
+	1, // 1: feast.core.FeatureViewProjection.join_key_map:type_name -> feast.core.FeatureViewProjection.JoinKeyMapEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_feast_core_FeatureViewProjection_proto_init() }
+func file_feast_core_FeatureViewProjection_proto_init() {
+	if File_feast_core_FeatureViewProjection_proto != nil {
+		return
+	}
+	file_feast_core_Feature_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_feast_core_FeatureViewProjection_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeatureViewProjection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_feast_core_FeatureViewProjection_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_feast_core_FeatureViewProjection_proto_goTypes,
+		DependencyIndexes: file_feast_core_FeatureViewProjection_proto_depIdxs,
+		MessageInfos:      file_feast_core_FeatureViewProjection_proto_msgTypes,
+	}.Build()
+	File_feast_core_FeatureViewProjection_proto = out.File
+	file_feast_core_FeatureViewProjection_proto_rawDesc = nil
+	file_feast_core_FeatureViewProjection_proto_goTypes = nil
+	file_feast_core_FeatureViewProjection_proto_depIdxs = nil
+}
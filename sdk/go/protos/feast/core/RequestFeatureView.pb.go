@@ -0,0 +1,267 @@
+//
+// Copyright 2021 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.4
+// source: feast/core/RequestFeatureView.proto
+
+package core
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RequestFeatureView struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// User-specified specifications of this feature view.
+	Spec *RequestFeatureViewSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (x *RequestFeatureView) Reset() {
+	*x = RequestFeatureView{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feast_core_RequestFeatureView_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestFeatureView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestFeatureView) ProtoMessage() {}
+
+func (x *RequestFeatureView) ProtoReflect() protoreflect.Message {
+	mi := &file_feast_core_RequestFeatureView_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestFeatureView.ProtoReflect.Descriptor instead.
+func (*RequestFeatureView) Descriptor() ([]byte, []int) {
+	return file_feast_core_RequestFeatureView_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequestFeatureView) GetSpec() *RequestFeatureViewSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type RequestFeatureViewSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the feature view. Must be unique. Not updated.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of Feast project that this feature view belongs to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// Request data which contains the underlying data schema and list of associated features
+	RequestDataSource *DataSource `protobuf:"bytes,3,opt,name=request_data_source,json=requestDataSource,proto3" json:"request_data_source,omitempty"`
+}
+
+func (x *RequestFeatureViewSpec) Reset() {
+	*x = RequestFeatureViewSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feast_core_RequestFeatureView_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestFeatureViewSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestFeatureViewSpec) ProtoMessage() {}
+
+func (x *RequestFeatureViewSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_feast_core_RequestFeatureView_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestFeatureViewSpec.ProtoReflect.Descriptor instead.
+func (*RequestFeatureViewSpec) Descriptor() ([]byte, []int) {
+	return file_feast_core_RequestFeatureView_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestFeatureViewSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RequestFeatureViewSpec) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *RequestFeatureViewSpec) GetRequestDataSource() *DataSource {
+	if x != nil {
+		return x.RequestDataSource
+	}
+	return nil
+}
+
+var File_feast_core_RequestFeatureView_proto protoreflect.FileDescriptor
+
+var file_feast_core_RequestFeatureView_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x69, 0x65, 0x77, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x1a, 0x1c, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x18, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x46, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x66, 0x65, 0x61, 0x73, 0x74,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x69, 0x65, 0x77, 0x12, 0x36, 0x0a, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x65, 0x61,
+	0x73, 0x74, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x69, 0x65, 0x77, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x22, 0x8e, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x69, 0x65, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x46, 0x0a,
+	0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x65, 0x61,
+	0x73, 0x74, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x60, 0x0a, 0x10, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66,
+	0x65, 0x61, 0x73, 0x74, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x73,
+	0x64, 0x6b, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x66, 0x65, 0x61,
+	0x73, 0x74, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_feast_core_RequestFeatureView_proto_rawDescOnce sync.Once
+	file_feast_core_RequestFeatureView_proto_rawDescData = file_feast_core_RequestFeatureView_proto_rawDesc
+)
+
+func file_feast_core_RequestFeatureView_proto_rawDescGZIP() []byte {
+	file_feast_core_RequestFeatureView_proto_rawDescOnce.Do(func() {
+		file_feast_core_RequestFeatureView_proto_rawDescData = protoimpl.X.CompressGZIP(file_feast_core_RequestFeatureView_proto_rawDescData)
+	})
+	return file_feast_core_RequestFeatureView_proto_rawDescData
+}
+
+var file_feast_core_RequestFeatureView_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_feast_core_RequestFeatureView_proto_goTypes = []interface{}{
+	(*RequestFeatureView)(nil),     // 0: feast.core.RequestFeatureView
+	(*RequestFeatureViewSpec)(nil), // 1: feast.core.RequestFeatureViewSpec
+	(*DataSource)(nil),             // 2: feast.core.DataSource
+}
+var file_feast_core_RequestFeatureView_proto_depIdxs = []int32{
+	1, // 0: feast.core.RequestFeatureView.spec:type_name -> feast.core.RequestFeatureViewSpec
+	2, // 1: feast.core.RequestFeatureViewSpec.request_data_source:type_name -> feast.core.DataSource
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_feast_core_RequestFeatureView_proto_init() }
+func file_feast_core_RequestFeatureView_proto_init() {
+	if File_feast_core_RequestFeatureView_proto != nil {
+		return
+	}
+	file_feast_core_FeatureView_proto_init()
+	file_feast_core_Feature_proto_init()
+	file_feast_core_DataSource_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_feast_core_RequestFeatureView_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestFeatureView); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feast_core_RequestFeatureView_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestFeatureViewSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_feast_core_RequestFeatureView_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_feast_core_RequestFeatureView_proto_goTypes,
+		DependencyIndexes: file_feast_core_RequestFeatureView_proto_depIdxs,
+		MessageInfos:      file_feast_core_RequestFeatureView_proto_msgTypes,
+	}.Build()
+	File_feast_core_RequestFeatureView_proto = out.File
+	file_feast_core_RequestFeatureView_proto_rawDesc = nil
+	file_feast_core_RequestFeatureView_proto_goTypes = nil
+	file_feast_core_RequestFeatureView_proto_depIdxs = nil
+}
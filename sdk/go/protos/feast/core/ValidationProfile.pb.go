@@ -0,0 +1,407 @@
+//
+// Copyright 2021 The Feast Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.4
+// source: feast/core/ValidationProfile.proto
+
+package core
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GEValidationProfiler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiler *GEValidationProfiler_UserDefinedProfiler `protobuf:"bytes,1,opt,name=profiler,proto3" json:"profiler,omitempty"`
+}
+
+func (x *GEValidationProfiler) Reset() {
+	*x = GEValidationProfiler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feast_core_ValidationProfile_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GEValidationProfiler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GEValidationProfiler) ProtoMessage() {}
+
+func (x *GEValidationProfiler) ProtoReflect() protoreflect.Message {
+	mi := &file_feast_core_ValidationProfile_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GEValidationProfiler.ProtoReflect.Descriptor instead.
+func (*GEValidationProfiler) Descriptor() ([]byte, []int) {
+	return file_feast_core_ValidationProfile_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GEValidationProfiler) GetProfiler() *GEValidationProfiler_UserDefinedProfiler {
+	if x != nil {
+		return x.Profiler
+	}
+	return nil
+}
+
+type GEValidationProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON-serialized ExpectationSuite object
+	ExpectationSuite []byte `protobuf:"bytes,1,opt,name=expectation_suite,json=expectationSuite,proto3" json:"expectation_suite,omitempty"`
+}
+
+func (x *GEValidationProfile) Reset() {
+	*x = GEValidationProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feast_core_ValidationProfile_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GEValidationProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GEValidationProfile) ProtoMessage() {}
+
+func (x *GEValidationProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_feast_core_ValidationProfile_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GEValidationProfile.ProtoReflect.Descriptor instead.
+func (*GEValidationProfile) Descriptor() ([]byte, []int) {
+	return file_feast_core_ValidationProfile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GEValidationProfile) GetExpectationSuite() []byte {
+	if x != nil {
+		return x.ExpectationSuite
+	}
+	return nil
+}
+
+type ValidationReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dataset *SavedDataset `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// Types that are assignable to Profiler:
+	//	*ValidationReference_GeProfiler
+	Profiler isValidationReference_Profiler `protobuf_oneof:"profiler"`
+}
+
+func (x *ValidationReference) Reset() {
+	*x = ValidationReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feast_core_ValidationProfile_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationReference) ProtoMessage() {}
+
+func (x *ValidationReference) ProtoReflect() protoreflect.Message {
+	mi := &file_feast_core_ValidationProfile_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationReference.ProtoReflect.Descriptor instead.
+func (*ValidationReference) Descriptor() ([]byte, []int) {
+	return file_feast_core_ValidationProfile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidationReference) GetDataset() *SavedDataset {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (m *ValidationReference) GetProfiler() isValidationReference_Profiler {
+	if m != nil {
+		return m.Profiler
+	}
+	return nil
+}
+
+func (x *ValidationReference) GetGeProfiler() *GEValidationProfiler {
+	if x, ok := x.GetProfiler().(*ValidationReference_GeProfiler); ok {
+		return x.GeProfiler
+	}
+	return nil
+}
+
+type isValidationReference_Profiler interface {
+	isValidationReference_Profiler()
+}
+
+type ValidationReference_GeProfiler struct {
+	GeProfiler *GEValidationProfiler `protobuf:"bytes,2,opt,name=ge_profiler,json=geProfiler,proto3,oneof"`
+}
+
+func (*ValidationReference_GeProfiler) isValidationReference_Profiler() {}
+
+type GEValidationProfiler_UserDefinedProfiler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The python-syntax function body (serialized by dill)
+	Body []byte `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *GEValidationProfiler_UserDefinedProfiler) Reset() {
+	*x = GEValidationProfiler_UserDefinedProfiler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feast_core_ValidationProfile_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GEValidationProfiler_UserDefinedProfiler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GEValidationProfiler_UserDefinedProfiler) ProtoMessage() {}
+
+func (x *GEValidationProfiler_UserDefinedProfiler) ProtoReflect() protoreflect.Message {
+	mi := &file_feast_core_ValidationProfile_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GEValidationProfiler_UserDefinedProfiler.ProtoReflect.Descriptor instead.
+func (*GEValidationProfiler_UserDefinedProfiler) Descriptor() ([]byte, []int) {
+	return file_feast_core_ValidationProfile_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *GEValidationProfiler_UserDefinedProfiler) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+var File_feast_core_ValidationProfile_proto protoreflect.FileDescriptor
+
+var file_feast_core_ValidationProfile_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1d, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x93, 0x01, 0x0a, 0x14, 0x47, 0x45, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x66, 0x65,
+	0x61, 0x73, 0x74, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x45, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x1a, 0x29, 0x0a, 0x13, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x42, 0x0a, 0x13, 0x47, 0x45, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x69,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x07, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x65,
+	0x61, 0x73, 0x74, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x45, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x0a, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x42, 0x5a, 0x0a, 0x10, 0x66, 0x65, 0x61, 0x73, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x11, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5a, 0x33,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x65, 0x61, 0x73, 0x74,
+	0x2d, 0x64, 0x65, 0x76, 0x2f, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x73, 0x64, 0x6b, 0x2f, 0x67,
+	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x66, 0x65, 0x61, 0x73, 0x74, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_feast_core_ValidationProfile_proto_rawDescOnce sync.Once
+	file_feast_core_ValidationProfile_proto_rawDescData = file_feast_core_ValidationProfile_proto_rawDesc
+)
+
+func file_feast_core_ValidationProfile_proto_rawDescGZIP() []byte {
+	file_feast_core_ValidationProfile_proto_rawDescOnce.Do(func() {
+		file_feast_core_ValidationProfile_proto_rawDescData = protoimpl.X.CompressGZIP(file_feast_core_ValidationProfile_proto_rawDescData)
+	})
+	return file_feast_core_ValidationProfile_proto_rawDescData
+}
+
+var file_feast_core_ValidationProfile_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_feast_core_ValidationProfile_proto_goTypes = []interface{}{
+	(*GEValidationProfiler)(nil),                     // 0: feast.core.GEValidationProfiler
+	(*GEValidationProfile)(nil),                      // 1: feast.core.GEValidationProfile
+	(*ValidationReference)(nil),                      // 2: feast.core.ValidationReference
+	(*GEValidationProfiler_UserDefinedProfiler)(nil), // 3: feast.core.GEValidationProfiler.UserDefinedProfiler
+	(*SavedDataset)(nil),                             // 4: feast.core.SavedDataset
+}
+var file_feast_core_ValidationProfile_proto_depIdxs = []int32{
+	3, // 0: feast.core.GEValidationProfiler.profiler:type_name -> feast.core.GEValidationProfiler.UserDefinedProfiler
+	4, // 1: feast.core.ValidationReference.dataset:type_name -> feast.core.SavedDataset
+	0, // 2: feast.core.ValidationReference.ge_profiler:type_name -> feast.core.GEValidationProfiler
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_feast_core_ValidationProfile_proto_init() }
+func file_feast_core_ValidationProfile_proto_init() {
+	if File_feast_core_ValidationProfile_proto != nil {
+		return
+	}
+	file_feast_core_SavedDataset_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_feast_core_ValidationProfile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GEValidationProfiler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feast_core_ValidationProfile_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GEValidationProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feast_core_ValidationProfile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feast_core_ValidationProfile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GEValidationProfiler_UserDefinedProfiler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_feast_core_ValidationProfile_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*ValidationReference_GeProfiler)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_feast_core_ValidationProfile_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_feast_core_ValidationProfile_proto_goTypes,
+		DependencyIndexes: file_feast_core_ValidationProfile_proto_depIdxs,
+		MessageInfos:      file_feast_core_ValidationProfile_proto_msgTypes,
+	}.Build()
+	File_feast_core_ValidationProfile_proto = out.File
+	file_feast_core_ValidationProfile_proto_rawDesc = nil
+	file_feast_core_ValidationProfile_proto_goTypes = nil
+	file_feast_core_ValidationProfile_proto_depIdxs = nil
+}
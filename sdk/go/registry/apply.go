@@ -0,0 +1,34 @@
+package registry
+
+import (
+	"context"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+// ApplySpecs applies the specs to the given project of the registry in the store, creating the registry if it
+// does not exist, and returns the changes made. Nothing is written if dryRun is set or nothing changed.
+// Returns ErrVersionConflict if the registry was updated concurrently, and a *feast.ValidationError if the
+// specs are invalid.
+func ApplySpecs(ctx context.Context, store RegistryStore, project string, specs *feast.Specs, dryRun bool) (*Plan, error) {
+	current, err := store.Get(ctx)
+	if err == ErrRegistryNotFound {
+		current = &core.Registry{}
+	} else if err != nil {
+		return nil, err
+	}
+	desired, err := specs.MergeRegistry(project, current)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := ComputePlan(current, desired)
+	if dryRun || !plan.HasChanges() {
+		return plan, nil
+	}
+	if _, err := store.Put(ctx, desired, current.GetVersionId()); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
@@ -0,0 +1,129 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/google/go-cmp/cmp"
+)
+
+const applySpecs = `kind: Entity
+spec:
+  name: driver
+  value_type: INT64
+---
+kind: FeatureView
+spec:
+  name: driver_stats
+  entities: [driver]
+  features:
+    - name: rating
+      value_type: DOUBLE
+  batch_source:
+    type: BATCH_FILE
+    event_timestamp_column: event_timestamp
+    file_options:
+      file_url: driver_stats.parquet
+`
+
+const applyFeatureTableSpecs = `kind: FeatureTable
+spec:
+  name: driver_trips
+  entities: [driver]
+  features:
+    - name: trips
+      value_type: %s
+`
+
+func TestApplySpecs(t *testing.T) {
+	ctx := context.Background()
+	store := NewFileStore(filepath.Join(t.TempDir(), "registry.db"))
+	specs, err := feast.ParseSpecs("driver.yaml", []byte(applySpecs))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Dry runs do not create the registry.
+	if _, err := ApplySpecs(ctx, store, "driver_project", specs, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(ctx); err != ErrRegistryNotFound {
+		t.Fatalf("Expected ErrRegistryNotFound, got %v", err)
+	}
+
+	plan, err := ApplySpecs(ctx, store, "driver_project", specs, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ObjectChange{
+		{Type: Added, Kind: KindEntity, Project: "driver_project", Name: "driver"},
+		{Type: Added, Kind: KindFeatureView, Project: "driver_project", Name: "driver_stats"},
+	}
+	if diff := cmp.Diff(want, plan.Changes); diff != "" {
+		t.Errorf("Unexpected changes (-want +got):\n%s", diff)
+	}
+	stored, err := store.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Applying unchanged specs does not write the registry.
+	plan, err = ApplySpecs(ctx, store, "driver_project", specs, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.HasChanges() {
+		t.Errorf("Expected no changes, got %v", plan.Changes)
+	}
+	unchanged, err := store.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unchanged.GetVersionId() != stored.GetVersionId() {
+		t.Error("Expected the registry not to be written")
+	}
+
+	// Changes to feature tables alone are planned and written.
+	writes := []struct {
+		valueType string
+		want      []ObjectChange
+	}{
+		{
+			valueType: "INT64",
+			want:      []ObjectChange{{Type: Added, Kind: KindFeatureTable, Project: "driver_project", Name: "driver_trips"}},
+		},
+		{
+			valueType: "INT32",
+			want: []ObjectChange{{
+				Type: Updated, Kind: KindFeatureTable, Project: "driver_project", Name: "driver_trips",
+				Fields: []FieldChange{{Path: "features[trips].value_type", Old: "INT64", New: "INT32", Destructive: "feature type change"}},
+			}},
+		},
+	}
+	for _, write := range writes {
+		specs, err := feast.ParseSpecs("driver.yaml", []byte(applySpecs+"---\n"+fmt.Sprintf(applyFeatureTableSpecs, write.valueType)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, dryRun := range []bool{true, false} {
+			plan, err = ApplySpecs(ctx, store, "driver_project", specs, dryRun)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(write.want, plan.Changes); diff != "" {
+				t.Errorf("Unexpected changes (-want +got):\n%s", diff)
+			}
+		}
+		written, err := store.Get(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if written.GetVersionId() == stored.GetVersionId() {
+			t.Error("Expected the registry to be written")
+		}
+		stored = written
+	}
+}
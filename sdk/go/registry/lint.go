@@ -0,0 +1,502 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+// LintSuppressTag is the tag (or entity label) listing the lint rules suppressed for an object,
+// as a comma separated list of rule names, or "*" to suppress all rules.
+const LintSuppressTag = "feast.dev/lint-suppress"
+
+// Severity is the severity of a lint finding.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+)
+
+var severityNames = map[Severity]string{
+	SeverityInfo:    "info",
+	SeverityWarning: "warning",
+	SeverityError:   "error",
+}
+
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// ParseSeverity parses a severity name, ie. "warning".
+func ParseSeverity(name string) (Severity, error) {
+	for severity, severityName := range severityNames {
+		if strings.EqualFold(name, severityName) {
+			return severity, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown severity: %s", name)
+}
+
+// MarshalText encodes the severity as its name.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a severity from its name.
+func (s *Severity) UnmarshalText(text []byte) error {
+	severity, err := ParseSeverity(string(text))
+	if err != nil {
+		return err
+	}
+	*s = severity
+	return nil
+}
+
+// Finding is a problem with a registry object found by a lint rule.
+type Finding struct {
+	// Rule and Severity are set by the linter from the rule reporting the finding.
+	Rule     string     `json:"rule"`
+	Severity Severity   `json:"severity"`
+	Kind     ObjectKind `json:"kind"`
+	Project  string     `json:"project"`
+	Name     string     `json:"name"`
+	Message  string     `json:"message"`
+}
+
+// LintRule checks registries for a single kind of problem.
+type LintRule struct {
+	// Name of the rule, used to suppress and configure the rule, ie. "undefined-entity".
+	Name        string
+	Description string
+	// Default severity of the findings of the rule.
+	Severity Severity
+	// Check returns the findings of the rule for the given registry.
+	Check func(registry *core.Registry) []Finding
+}
+
+// Linter checks registries against a set of lint rules.
+type Linter struct {
+	rules      []LintRule
+	severities map[string]Severity
+	disabled   map[string]bool
+}
+
+// NewLinter creates a Linter with the given rules. Use BuiltinLintRules to lint with the built-in rules.
+func NewLinter(rules ...LintRule) *Linter {
+	return &Linter{
+		rules:      rules,
+		severities: make(map[string]Severity),
+		disabled:   make(map[string]bool),
+	}
+}
+
+// AddRule adds a custom rule to the linter.
+func (l *Linter) AddRule(rule LintRule) {
+	l.rules = append(l.rules, rule)
+}
+
+// SetSeverity overrides the severity of the findings of the rule with the given name.
+func (l *Linter) SetSeverity(rule string, severity Severity) {
+	l.severities[rule] = severity
+}
+
+// Disable disables the rule with the given name.
+func (l *Linter) Disable(rule string) {
+	l.disabled[rule] = true
+}
+
+// Rules returns the rules of the linter.
+func (l *Linter) Rules() []LintRule {
+	return l.rules
+}
+
+// Lint checks the registry against the enabled rules of the linter.
+// Findings for objects suppressing the rule through the LintSuppressTag tag are dropped.
+// Findings are ordered by severity, highest first, then kind, project, name and rule.
+func (l *Linter) Lint(registry *core.Registry) *LintReport {
+	suppressed := suppressedRules(registry)
+	report := &LintReport{Findings: []Finding{}}
+	for _, rule := range l.rules {
+		if l.disabled[rule.Name] {
+			continue
+		}
+		severity := rule.Severity
+		if override, ok := l.severities[rule.Name]; ok {
+			severity = override
+		}
+		for _, finding := range rule.Check(registry) {
+			rules := suppressed[finding.Kind][objectKey{finding.Project, finding.Name}]
+			if rules[rule.Name] || rules["*"] {
+				continue
+			}
+			finding.Rule = rule.Name
+			finding.Severity = severity
+			report.Findings = append(report.Findings, finding)
+		}
+	}
+
+	kindOrder := make(map[ObjectKind]int)
+	for i, kind := range objectKinds {
+		kindOrder[kind] = i
+	}
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		a, b := report.Findings[i], report.Findings[j]
+		switch {
+		case a.Severity != b.Severity:
+			return a.Severity > b.Severity
+		case a.Kind != b.Kind:
+			return kindOrder[a.Kind] < kindOrder[b.Kind]
+		case a.Project != b.Project:
+			return a.Project < b.Project
+		case a.Name != b.Name:
+			return a.Name < b.Name
+		default:
+			return a.Rule < b.Rule
+		}
+	})
+	return report
+}
+
+// Returns the rules suppressed by each tagged object of the registry.
+func suppressedRules(registry *core.Registry) map[ObjectKind]map[objectKey]map[string]bool {
+	suppressed := make(map[ObjectKind]map[objectKey]map[string]bool)
+	add := func(kind ObjectKind, project string, name string, tags map[string]string) {
+		value, ok := tags[LintSuppressTag]
+		if !ok {
+			return
+		}
+		if suppressed[kind] == nil {
+			suppressed[kind] = make(map[objectKey]map[string]bool)
+		}
+		rules := make(map[string]bool)
+		for _, rule := range strings.Split(value, ",") {
+			rules[strings.TrimSpace(rule)] = true
+		}
+		suppressed[kind][objectKey{project, name}] = rules
+	}
+	for _, entity := range registry.GetEntities() {
+		add(KindEntity, entity.GetSpec().GetProject(), entity.GetSpec().GetName(), entity.GetSpec().GetLabels())
+	}
+	for _, featureView := range registry.GetFeatureViews() {
+		add(KindFeatureView, featureView.GetSpec().GetProject(), featureView.GetSpec().GetName(), featureView.GetSpec().GetTags())
+	}
+	for _, featureService := range registry.GetFeatureServices() {
+		add(KindFeatureService, featureService.GetSpec().GetProject(), featureService.GetSpec().GetName(), featureService.GetSpec().GetTags())
+	}
+	return suppressed
+}
+
+// LintReport holds the findings of linting a registry.
+type LintReport struct {
+	Findings []Finding `json:"findings"`
+}
+
+// HasFindings reports whether the report has findings of at least the given severity.
+func (r *LintReport) HasFindings(severity Severity) bool {
+	for _, finding := range r.Findings {
+		if finding.Severity >= severity {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteJSON writes the report to w as indented JSON.
+func (r *LintReport) WriteJSON(w io.Writer) error {
+	reportJSON, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", reportJSON)
+	return err
+}
+
+// WriteText writes the findings of the report to w, one per line.
+func (r *LintReport) WriteText(w io.Writer) error {
+	var b strings.Builder
+	counts := make(map[Severity]int)
+	for _, finding := range r.Findings {
+		counts[finding.Severity]++
+		fmt.Fprintf(&b, "%s: %s %s/%s: %s [%s]\n",
+			finding.Severity, finding.Kind, finding.Project, finding.Name, finding.Message, finding.Rule)
+	}
+	fmt.Fprintf(&b, "%d errors, %d warnings, %d info.\n", counts[SeverityError], counts[SeverityWarning], counts[SeverityInfo])
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Names of registry objects must be valid identifiers in the offline and online stores.
+var validName = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// BuiltinLintRules returns the built-in lint rules:
+//   - undefined-entity: feature views referencing entities not defined in their project.
+//   - undefined-feature-view: feature services and on demand feature views referencing undefined feature views.
+//   - undefined-feature: feature services projecting features their feature views do not define.
+//   - feature-type-mismatch: feature services projecting features with a type other than the feature view's.
+//   - duplicate-feature: feature names defined by multiple feature views joined on a shared entity.
+//   - invalid-name: object and feature names that are not lower snake case.
+func BuiltinLintRules() []LintRule {
+	return []LintRule{
+		{
+			Name:        "undefined-entity",
+			Description: "Feature views must only reference entities defined in their project.",
+			Severity:    SeverityError,
+			Check:       checkUndefinedEntities,
+		},
+		{
+			Name:        "undefined-feature-view",
+			Description: "Feature services and on demand feature views must only reference feature views defined in their project.",
+			Severity:    SeverityError,
+			Check:       checkUndefinedFeatureViews,
+		},
+		{
+			Name:        "undefined-feature",
+			Description: "Feature services must only project features defined by their feature views.",
+			Severity:    SeverityError,
+			Check:       checkUndefinedFeatures,
+		},
+		{
+			Name:        "feature-type-mismatch",
+			Description: "Features projected by feature services must have the type defined by their feature views.",
+			Severity:    SeverityError,
+			Check:       checkFeatureTypeMismatches,
+		},
+		{
+			Name:        "duplicate-feature",
+			Description: "Feature views sharing an entity should not define features with the same name.",
+			Severity:    SeverityWarning,
+			Check:       checkDuplicateFeatures,
+		},
+		{
+			Name:        "invalid-name",
+			Description: "Names of objects and features should be lower snake case.",
+			Severity:    SeverityWarning,
+			Check:       checkNames,
+		},
+	}
+}
+
+func checkUndefinedEntities(registry *core.Registry) []Finding {
+	s := newSnapshot(registry)
+	var findings []Finding
+	for _, featureView := range registry.GetFeatureViews() {
+		spec := featureView.GetSpec()
+		for _, entity := range spec.GetEntities() {
+			if _, ok := s.entities[objectKey{spec.GetProject(), entity}]; !ok {
+				findings = append(findings, Finding{
+					Kind:    KindFeatureView,
+					Project: spec.GetProject(),
+					Name:    spec.GetName(),
+					Message: fmt.Sprintf("references undefined entity %s", entity),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func checkUndefinedFeatureViews(registry *core.Registry) []Finding {
+	s := newSnapshot(registry)
+	defined := func(project string, name string) bool {
+		key := objectKey{project, name}
+		_, isFeatureView := s.featureViews[key]
+		_, isOnDemandFeatureView := s.onDemandFeatureViews[key]
+		return isFeatureView || isOnDemandFeatureView
+	}
+	var findings []Finding
+	for _, featureService := range registry.GetFeatureServices() {
+		spec := featureService.GetSpec()
+		for _, projection := range spec.GetFeatures() {
+			if !defined(spec.GetProject(), projection.GetFeatureViewName()) {
+				findings = append(findings, Finding{
+					Kind:    KindFeatureService,
+					Project: spec.GetProject(),
+					Name:    spec.GetName(),
+					Message: fmt.Sprintf("references undefined feature view %s", projection.GetFeatureViewName()),
+				})
+			}
+		}
+	}
+	for _, odfv := range registry.GetOnDemandFeatureViews() {
+		spec := odfv.GetSpec()
+		inputs := make([]string, 0, len(spec.GetInputs()))
+		for input := range spec.GetInputs() {
+			inputs = append(inputs, input)
+		}
+		sort.Strings(inputs)
+		for _, input := range inputs {
+			name := spec.GetInputs()[input].GetFeatureViewProjection().GetFeatureViewName()
+			if name == "" {
+				name = spec.GetInputs()[input].GetFeatureView().GetSpec().GetName()
+			}
+			if name != "" && !defined(spec.GetProject(), name) {
+				findings = append(findings, Finding{
+					Kind:    KindOnDemandFeatureView,
+					Project: spec.GetProject(),
+					Name:    spec.GetName(),
+					Message: fmt.Sprintf("input %s references undefined feature view %s", input, name),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// Calls check for each feature projected by the feature services of the registry, along with the
+// feature of the same name defined by the projected feature view, or nil if it's not defined.
+// Projections of undefined feature views are skipped.
+func forEachProjectedFeature(registry *core.Registry, check func(service *core.FeatureServiceSpec, projection *core.FeatureViewProjection, projected *core.FeatureSpecV2, defined *core.FeatureSpecV2)) {
+	s := newSnapshot(registry)
+	for _, featureService := range registry.GetFeatureServices() {
+		spec := featureService.GetSpec()
+		for _, projection := range spec.GetFeatures() {
+			key := objectKey{spec.GetProject(), projection.GetFeatureViewName()}
+			var features []*core.FeatureSpecV2
+			if featureView, ok := s.featureViews[key]; ok {
+				features = featureView.GetSpec().GetFeatures()
+			} else if odfv, ok := s.onDemandFeatureViews[key]; ok {
+				features = odfv.GetSpec().GetFeatures()
+			} else {
+				continue
+			}
+			for _, projected := range projection.GetFeatureColumns() {
+				var defined *core.FeatureSpecV2
+				for _, feature := range features {
+					if feature.GetName() == projected.GetName() {
+						defined = feature
+						break
+					}
+				}
+				check(spec, projection, projected, defined)
+			}
+		}
+	}
+}
+
+func checkUndefinedFeatures(registry *core.Registry) []Finding {
+	var findings []Finding
+	forEachProjectedFeature(registry, func(service *core.FeatureServiceSpec, projection *core.FeatureViewProjection, projected *core.FeatureSpecV2, defined *core.FeatureSpecV2) {
+		if defined == nil {
+			findings = append(findings, Finding{
+				Kind:    KindFeatureService,
+				Project: service.GetProject(),
+				Name:    service.GetName(),
+				Message: fmt.Sprintf("projects feature %s:%s which is not defined", projection.GetFeatureViewName(), projected.GetName()),
+			})
+		}
+	})
+	return findings
+}
+
+func checkFeatureTypeMismatches(registry *core.Registry) []Finding {
+	var findings []Finding
+	forEachProjectedFeature(registry, func(service *core.FeatureServiceSpec, projection *core.FeatureViewProjection, projected *core.FeatureSpecV2, defined *core.FeatureSpecV2) {
+		if defined != nil && defined.GetValueType() != projected.GetValueType() {
+			findings = append(findings, Finding{
+				Kind:    KindFeatureService,
+				Project: service.GetProject(),
+				Name:    service.GetName(),
+				Message: fmt.Sprintf("projects feature %s:%s as %s but it is defined as %s",
+					projection.GetFeatureViewName(), projected.GetName(), projected.GetValueType(), defined.GetValueType()),
+			})
+		}
+	})
+	return findings
+}
+
+func checkDuplicateFeatures(registry *core.Registry) []Finding {
+	// Feature views defining each feature, keyed by project and feature name.
+	type featureKey struct {
+		project string
+		feature string
+	}
+	definedBy := make(map[featureKey][]*core.FeatureViewSpec)
+	var keys []featureKey
+	for _, featureView := range registry.GetFeatureViews() {
+		spec := featureView.GetSpec()
+		for _, feature := range spec.GetFeatures() {
+			key := featureKey{spec.GetProject(), feature.GetName()}
+			if _, ok := definedBy[key]; !ok {
+				keys = append(keys, key)
+			}
+			definedBy[key] = append(definedBy[key], spec)
+		}
+	}
+
+	var findings []Finding
+	for _, key := range keys {
+		featureViews := definedBy[key]
+		for _, featureView := range featureViews {
+			var others []string
+			for _, other := range featureViews {
+				if other != featureView && entitiesOverlap(featureView.GetEntities(), other.GetEntities()) {
+					others = append(others, other.GetName())
+				}
+			}
+			if len(others) == 0 {
+				continue
+			}
+			findings = append(findings, Finding{
+				Kind:    KindFeatureView,
+				Project: key.project,
+				Name:    featureView.GetName(),
+				Message: fmt.Sprintf("feature %s is also defined by %s, joined on a shared entity", key.feature, strings.Join(others, ", ")),
+			})
+		}
+	}
+	return findings
+}
+
+// Reports whether feature views with the given entities share an entity, or both have no entities.
+func entitiesOverlap(a []string, b []string) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func checkNames(registry *core.Registry) []Finding {
+	var findings []Finding
+	check := func(kind ObjectKind, project string, name string, features []*core.FeatureSpecV2) {
+		if !validName.MatchString(name) {
+			findings = append(findings, Finding{Kind: kind, Project: project, Name: name,
+				Message: fmt.Sprintf("name %s is not lower snake case", name)})
+		}
+		for _, feature := range features {
+			if !validName.MatchString(feature.GetName()) {
+				findings = append(findings, Finding{Kind: kind, Project: project, Name: name,
+					Message: fmt.Sprintf("feature name %s is not lower snake case", feature.GetName())})
+			}
+		}
+	}
+	for _, entity := range registry.GetEntities() {
+		check(KindEntity, entity.GetSpec().GetProject(), entity.GetSpec().GetName(), nil)
+	}
+	for _, featureView := range registry.GetFeatureViews() {
+		check(KindFeatureView, featureView.GetSpec().GetProject(), featureView.GetSpec().GetName(), featureView.GetSpec().GetFeatures())
+	}
+	for _, odfv := range registry.GetOnDemandFeatureViews() {
+		check(KindOnDemandFeatureView, odfv.GetSpec().GetProject(), odfv.GetSpec().GetName(), odfv.GetSpec().GetFeatures())
+	}
+	for _, featureService := range registry.GetFeatureServices() {
+		check(KindFeatureService, featureService.GetSpec().GetProject(), featureService.GetSpec().GetName(), nil)
+	}
+	return findings
+}
@@ -0,0 +1,176 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLint(t *testing.T) {
+	tt := []struct {
+		name   string
+		update func(registry *core.Registry)
+		want   []Finding
+	}{
+		{
+			name:   "Valid registry",
+			update: func(registry *core.Registry) {},
+			want:   []Finding{},
+		},
+		{
+			name: "Undefined entity",
+			update: func(registry *core.Registry) {
+				registry.Entities = registry.Entities[:1]
+			},
+			want: []Finding{
+				{Rule: "undefined-entity", Severity: SeverityError, Kind: KindFeatureView, Project: "customer_project", Name: "customer_stats", Message: "references undefined entity customer"},
+			},
+		},
+		{
+			name: "Undefined and mistyped projected features",
+			update: func(registry *core.Registry) {
+				registry.FeatureServices[0].Spec.Features = []*core.FeatureViewProjection{
+					{FeatureViewName: "driver_stats", FeatureColumns: []*core.FeatureSpecV2{
+						{Name: "rating", ValueType: types.ValueType_INT64},
+						{Name: "acceptance_rate", ValueType: types.ValueType_DOUBLE},
+					}},
+					{FeatureViewName: "vehicle_stats", FeatureColumns: []*core.FeatureSpecV2{{Name: "mileage"}}},
+				}
+			},
+			want: []Finding{
+				{Rule: "feature-type-mismatch", Severity: SeverityError, Kind: KindFeatureService, Project: "driver_project", Name: "driver_service", Message: "projects feature driver_stats:rating as INT64 but it is defined as DOUBLE"},
+				{Rule: "undefined-feature", Severity: SeverityError, Kind: KindFeatureService, Project: "driver_project", Name: "driver_service", Message: "projects feature driver_stats:acceptance_rate which is not defined"},
+				{Rule: "undefined-feature-view", Severity: SeverityError, Kind: KindFeatureService, Project: "driver_project", Name: "driver_service", Message: "references undefined feature view vehicle_stats"},
+			},
+		},
+		{
+			name: "Duplicate features and invalid names",
+			update: func(registry *core.Registry) {
+				registry.FeatureViews[1].Spec.Features = append(registry.FeatureViews[1].Spec.Features, &core.FeatureSpecV2{Name: "rating"})
+				registry.Entities[0].Spec.Name = "Driver"
+				registry.Entities = append(registry.Entities, &core.Entity{Spec: &core.EntitySpecV2{Name: "driver", Project: "driver_project"}})
+			},
+			want: []Finding{
+				{Rule: "invalid-name", Severity: SeverityWarning, Kind: KindEntity, Project: "driver_project", Name: "Driver", Message: "name Driver is not lower snake case"},
+				{Rule: "duplicate-feature", Severity: SeverityWarning, Kind: KindFeatureView, Project: "driver_project", Name: "driver_pii", Message: "feature rating is also defined by driver_stats, joined on a shared entity"},
+				{Rule: "duplicate-feature", Severity: SeverityWarning, Kind: KindFeatureView, Project: "driver_project", Name: "driver_stats", Message: "feature rating is also defined by driver_pii, joined on a shared entity"},
+			},
+		},
+		{
+			name: "Duplicate features of feature views sharing an entity",
+			update: func(registry *core.Registry) {
+				registry.Entities = append(registry.Entities,
+					&core.Entity{Spec: &core.EntitySpecV2{Name: "customer", Project: "driver_project"}},
+					&core.Entity{Spec: &core.EntitySpecV2{Name: "vehicle", Project: "driver_project"}},
+				)
+				registry.FeatureViews[1].Spec.Entities = []string{"driver", "customer"}
+				registry.FeatureViews[1].Spec.Features = append(registry.FeatureViews[1].Spec.Features, &core.FeatureSpecV2{Name: "rating"})
+				registry.FeatureViews = append(registry.FeatureViews, &core.FeatureView{Spec: &core.FeatureViewSpec{
+					Name:     "vehicle_stats",
+					Project:  "driver_project",
+					Entities: []string{"vehicle"},
+					Features: []*core.FeatureSpecV2{{Name: "rating", ValueType: types.ValueType_DOUBLE}},
+				}})
+			},
+			want: []Finding{
+				{Rule: "duplicate-feature", Severity: SeverityWarning, Kind: KindFeatureView, Project: "driver_project", Name: "driver_pii", Message: "feature rating is also defined by driver_stats, joined on a shared entity"},
+				{Rule: "duplicate-feature", Severity: SeverityWarning, Kind: KindFeatureView, Project: "driver_project", Name: "driver_stats", Message: "feature rating is also defined by driver_pii, joined on a shared entity"},
+			},
+		},
+		{
+			name: "Suppressed findings",
+			update: func(registry *core.Registry) {
+				registry.FeatureViews[1].Spec.Features = append(registry.FeatureViews[1].Spec.Features, &core.FeatureSpecV2{Name: "rating"})
+				registry.FeatureViews[0].Spec.Tags[LintSuppressTag] = "invalid-name, duplicate-feature"
+				registry.FeatureViews[1].Spec.Tags[LintSuppressTag] = "*"
+			},
+			want: []Finding{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := testRegistryProto("v1")
+			tc.update(registry)
+			report := NewLinter(BuiltinLintRules()...).Lint(registry)
+			if diff := cmp.Diff(tc.want, report.Findings); diff != "" {
+				t.Errorf("Unexpected findings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomLintRules(t *testing.T) {
+	requireOwner := LintRule{
+		Name:     "require-owner",
+		Severity: SeverityInfo,
+		Check: func(registry *core.Registry) []Finding {
+			var findings []Finding
+			for _, featureView := range registry.GetFeatureViews() {
+				if featureView.GetSpec().GetTags()["owner"] == "" {
+					findings = append(findings, Finding{Kind: KindFeatureView, Project: featureView.GetSpec().GetProject(), Name: featureView.GetSpec().GetName(), Message: "has no owner"})
+				}
+			}
+			return findings
+		},
+	}
+	registry := testRegistryProto("v1")
+	registry.FeatureViews = registry.FeatureViews[:1]
+	registry.Entities[0].Spec.Name = "Driver"
+
+	linter := NewLinter(BuiltinLintRules()...)
+	linter.AddRule(requireOwner)
+	linter.SetSeverity("require-owner", SeverityError)
+	linter.Disable("undefined-entity")
+	report := linter.Lint(registry)
+
+	want := []Finding{
+		{Rule: "require-owner", Severity: SeverityError, Kind: KindFeatureView, Project: "driver_project", Name: "driver_stats", Message: "has no owner"},
+		{Rule: "invalid-name", Severity: SeverityWarning, Kind: KindEntity, Project: "driver_project", Name: "Driver", Message: "name Driver is not lower snake case"},
+	}
+	if diff := cmp.Diff(want, report.Findings); diff != "" {
+		t.Errorf("Unexpected findings (-want +got):\n%s", diff)
+	}
+	if !report.HasFindings(SeverityError) {
+		t.Error("Expected report to have error findings")
+	}
+
+	var text bytes.Buffer
+	if err := report.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	wantText := "error: feature view driver_project/driver_stats: has no owner [require-owner]\n" +
+		"warning: entity driver_project/Driver: name Driver is not lower snake case [invalid-name]\n" +
+		"1 errors, 1 warnings, 0 info.\n"
+	if diff := cmp.Diff(wantText, text.String()); diff != "" {
+		t.Errorf("Unexpected text output (-want +got):\n%s", diff)
+	}
+
+	var jsonOutput bytes.Buffer
+	if err := report.WriteJSON(&jsonOutput); err != nil {
+		t.Fatal(err)
+	}
+	var decoded LintReport
+	if err := json.Unmarshal(jsonOutput.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(report, &decoded); diff != "" {
+		t.Errorf("Unexpected JSON output (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseSeverity(t *testing.T) {
+	for _, severity := range []Severity{SeverityInfo, SeverityWarning, SeverityError} {
+		got, err := ParseSeverity(severity.String())
+		if err != nil || got != severity {
+			t.Errorf("Expected %v, got %v: %v", severity, got, err)
+		}
+	}
+	if _, err := ParseSeverity("fatal"); err == nil {
+		t.Error("Expected error parsing unknown severity")
+	}
+}
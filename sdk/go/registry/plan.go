@@ -0,0 +1,388 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Names of the fields identifying elements of repeated message fields, ie. features by name.
+var keyFieldNames = []protoreflect.Name{"name", "feature_view_name"}
+
+// FieldChange is a change to a single field of a registry object spec.
+type FieldChange struct {
+	// Path of the field within the spec, with elements of repeated fields keyed by name,
+	// ie. "features[rating].value_type" or "batch_source.file_options.file_url".
+	Path string `json:"path"`
+	// Old value of the field, empty if the field was added.
+	Old string `json:"old,omitempty"`
+	// New value of the field, empty if the field was removed.
+	New string `json:"new,omitempty"`
+	// Reason the change is destructive, empty if the change is not destructive.
+	Destructive string `json:"destructive,omitempty"`
+}
+
+// ObjectChange is a registry object added, updated or deleted by a plan.
+type ObjectChange struct {
+	Type    ChangeType `json:"type"`
+	Kind    ObjectKind `json:"kind"`
+	Project string     `json:"project"`
+	Name    string     `json:"name"`
+	// Fields changed in the spec of updated objects.
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// Destructive reports whether any of the field changes is destructive.
+func (c ObjectChange) Destructive() bool {
+	for _, field := range c.Fields {
+		if field.Destructive != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Plan is the set of changes turning one registry into another.
+type Plan struct {
+	// Changes ordered by kind, project and name.
+	Changes []ObjectChange `json:"changes"`
+}
+
+// ComputePlan computes the changes required to turn the current registry into the desired registry.
+// Objects are compared by their specs, with changes to their metadata being ignored. Changes to feature
+// tables are listed after the changes to the other kinds of objects.
+// A nil current registry is treated as an empty registry.
+func ComputePlan(current *core.Registry, desired *core.Registry) *Plan {
+	plan := &Plan{Changes: []ObjectChange{}}
+	events := diffSnapshots(newSnapshot(current), newSnapshot(desired))
+	events = append(events, diffObjects(KindFeatureTable, featureTableIndex(current), featureTableIndex(desired), desired.GetVersionId())...)
+	for _, event := range events {
+		change := ObjectChange{Type: event.Type, Kind: event.Kind, Project: event.Project, Name: event.Name}
+		if event.Type == Updated {
+			change.Fields = diffSpecs(event.Kind, specOf(event.Old), specOf(event.New))
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+	return plan
+}
+
+// HasChanges reports whether the plan changes any objects.
+func (p *Plan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+// Destructive reports whether the plan contains any destructive changes.
+func (p *Plan) Destructive() bool {
+	for _, change := range p.Changes {
+		if change.Destructive() {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteJSON writes the plan to w as indented JSON.
+func (p *Plan) WriteJSON(w io.Writer) error {
+	planJSON, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", planJSON)
+	return err
+}
+
+// WriteText writes a human readable summary of the plan to w.
+func (p *Plan) WriteText(w io.Writer) error {
+	if !p.HasChanges() {
+		_, err := io.WriteString(w, "No changes.\n")
+		return err
+	}
+
+	var b strings.Builder
+	counts := make(map[ChangeType]int)
+	destructive := 0
+	for _, change := range p.Changes {
+		counts[change.Type]++
+		if change.Destructive() {
+			destructive++
+		}
+		fmt.Fprintf(&b, "%s %s %s/%s\n", changeSymbol(change.Type), change.Kind, change.Project, change.Name)
+		for _, field := range change.Fields {
+			switch {
+			case field.Old == "":
+				fmt.Fprintf(&b, "    + %s: %s", field.Path, field.New)
+			case field.New == "":
+				fmt.Fprintf(&b, "    - %s: %s", field.Path, field.Old)
+			default:
+				fmt.Fprintf(&b, "    ~ %s: %s -> %s", field.Path, field.Old, field.New)
+			}
+			if field.Destructive != "" {
+				fmt.Fprintf(&b, " (destructive: %s)", field.Destructive)
+			}
+			b.WriteString("\n")
+		}
+	}
+	fmt.Fprintf(&b, "\nPlan: %d to add, %d to update, %d to delete, %d destructive.\n",
+		counts[Added], counts[Updated], counts[Deleted], destructive)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func changeSymbol(changeType ChangeType) string {
+	switch changeType {
+	case Added:
+		return "+"
+	case Deleted:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+// Diffs the specs of two versions of a registry object, flagging destructive changes.
+func diffSpecs(kind ObjectKind, old proto.Message, new proto.Message) []FieldChange {
+	var changes []FieldChange
+	diffMessages("", proto.MessageReflect(old), proto.MessageReflect(new), &changes)
+	for i := range changes {
+		changes[i].Destructive = destructiveReason(kind, changes[i])
+	}
+	return changes
+}
+
+// Returns the reason a change to a field of an object spec is destructive,
+// or an empty string if it's not destructive.
+func destructiveReason(kind ObjectKind, change FieldChange) string {
+	if change.Old == "" || change.New == "" {
+		return ""
+	}
+	switch {
+	case kind == KindEntity && change.Path == "join_key":
+		return "entity join key change"
+	case kind == KindEntity && change.Path == "value_type":
+		return "entity type change"
+	case kind == KindFeatureView && change.Path == "entities":
+		return "feature view entities change"
+	case kind == KindFeatureTable && change.Path == "entities":
+		return "feature table entities change"
+	case strings.HasSuffix(change.Path, "].value_type"):
+		return "feature type change"
+	}
+	return ""
+}
+
+// Appends the changes between the fields of two messages of the same type to changes.
+func diffMessages(path string, old protoreflect.Message, new protoreflect.Message, changes *[]FieldChange) {
+	fields := old.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		fieldPath := joinPath(path, string(field.Name()))
+		switch {
+		case field.IsList():
+			diffLists(fieldPath, field, old.Get(field).List(), new.Get(field).List(), changes)
+		case field.IsMap():
+			diffMaps(fieldPath, field, old.Get(field).Map(), new.Get(field).Map(), changes)
+		case field.Message() != nil && !isWellKnown(field.Message()) && old.Has(field) && new.Has(field):
+			diffMessages(fieldPath, old.Get(field).Message(), new.Get(field).Message(), changes)
+		default:
+			diffValues(fieldPath, formatField(old, field), formatField(new, field), changes)
+		}
+	}
+}
+
+// Appends the changes between two lists to changes. Lists of messages with a name field
+// are diffed element by element, other lists are compared as a whole.
+func diffLists(path string, field protoreflect.FieldDescriptor, old protoreflect.List, new protoreflect.List, changes *[]FieldChange) {
+	keyField := listKeyField(field)
+	if keyField == nil {
+		diffValues(path, formatList(field, old), formatList(field, new), changes)
+		return
+	}
+
+	index := func(list protoreflect.List) (map[string]protoreflect.Message, []string) {
+		elements := make(map[string]protoreflect.Message)
+		var keys []string
+		for i := 0; i < list.Len(); i++ {
+			element := list.Get(i).Message()
+			key := element.Get(keyField).String()
+			if _, ok := elements[key]; !ok {
+				keys = append(keys, key)
+			}
+			elements[key] = element
+		}
+		return elements, keys
+	}
+	oldElements, oldKeys := index(old)
+	newElements, newKeys := index(new)
+	for _, key := range oldKeys {
+		if _, ok := newElements[key]; !ok {
+			diffValues(elementPath(path, key), formatMessage(oldElements[key]), "", changes)
+		}
+	}
+	for _, key := range newKeys {
+		elementPath := elementPath(path, key)
+		if oldElement, ok := oldElements[key]; ok {
+			diffMessages(elementPath, oldElement, newElements[key], changes)
+		} else {
+			diffValues(elementPath, "", formatMessage(newElements[key]), changes)
+		}
+	}
+}
+
+// Appends the changes between the entries of two maps to changes.
+func diffMaps(path string, field protoreflect.FieldDescriptor, old protoreflect.Map, new protoreflect.Map, changes *[]FieldChange) {
+	valueField := field.MapValue()
+	keys := sortedMapKeys(old, new)
+	for _, key := range keys {
+		mapKey := protoreflect.ValueOfString(key).MapKey()
+		elementPath := elementPath(path, key)
+		oldValue, newValue := "", ""
+		if old.Has(mapKey) {
+			oldValue = formatValue(valueField, old.Get(mapKey))
+		}
+		if new.Has(mapKey) {
+			newValue = formatValue(valueField, new.Get(mapKey))
+		}
+		if valueField.Message() != nil && !isWellKnown(valueField.Message()) && old.Has(mapKey) && new.Has(mapKey) {
+			diffMessages(elementPath, old.Get(mapKey).Message(), new.Get(mapKey).Message(), changes)
+			continue
+		}
+		diffValues(elementPath, oldValue, newValue, changes)
+	}
+}
+
+// Appends a change to changes if the formatted values differ.
+func diffValues(path string, old string, new string, changes *[]FieldChange) {
+	if old != new {
+		*changes = append(*changes, FieldChange{Path: path, Old: old, New: new})
+	}
+}
+
+// Returns the field used to key elements of the given repeated message field, or nil if there is none.
+func listKeyField(field protoreflect.FieldDescriptor) protoreflect.FieldDescriptor {
+	if field.Message() == nil {
+		return nil
+	}
+	for _, name := range keyFieldNames {
+		if keyField := field.Message().Fields().ByName(name); keyField != nil && keyField.Kind() == protoreflect.StringKind {
+			return keyField
+		}
+	}
+	return nil
+}
+
+// Returns the union of the keys of the given string keyed maps, sorted.
+func sortedMapKeys(maps ...protoreflect.Map) []string {
+	seen := make(map[string]struct{})
+	for _, m := range maps {
+		m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+			seen[key.String()] = struct{}{}
+			return true
+		})
+	}
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func elementPath(path string, key string) string {
+	return fmt.Sprintf("%s[%s]", path, key)
+}
+
+// Reports whether the message is a well known type formatted as a single value.
+func isWellKnown(message protoreflect.MessageDescriptor) bool {
+	switch message.FullName() {
+	case "google.protobuf.Duration", "google.protobuf.Timestamp":
+		return true
+	}
+	return false
+}
+
+// Formats the value of a field of the message, returning an empty string for unset message fields.
+func formatField(message protoreflect.Message, field protoreflect.FieldDescriptor) string {
+	if field.Message() != nil && !message.Has(field) {
+		return ""
+	}
+	return formatValue(field, message.Get(field))
+}
+
+// Formats a singular value of the given field for display.
+func formatValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return strconv.Quote(value.String())
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("%d bytes", len(value.Bytes()))
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return formatMessage(value.Message())
+	default:
+		return fmt.Sprint(value.Interface())
+	}
+}
+
+// Formats a list of values of the given field for display.
+func formatList(field protoreflect.FieldDescriptor, list protoreflect.List) string {
+	values := make([]string, list.Len())
+	for i := range values {
+		values[i] = formatValue(field, list.Get(i))
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+// Formats a message for display, listing its set fields in field number order.
+func formatMessage(message protoreflect.Message) string {
+	switch m := message.Interface().(type) {
+	case *durationpb.Duration:
+		return m.AsDuration().String()
+	case *timestamppb.Timestamp:
+		return m.AsTime().UTC().Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	var values []string
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if !message.Has(field) {
+			continue
+		}
+		var value string
+		switch {
+		case field.IsList():
+			value = formatList(field, message.Get(field).List())
+		case field.IsMap():
+			m := message.Get(field).Map()
+			var entries []string
+			for _, key := range sortedMapKeys(m) {
+				entries = append(entries, key+": "+formatValue(field.MapValue(), m.Get(protoreflect.ValueOfString(key).MapKey())))
+			}
+			value = "{" + strings.Join(entries, ", ") + "}"
+		default:
+			value = formatValue(field, message.Get(field))
+		}
+		values = append(values, fmt.Sprintf("%s: %s", field.Name(), value))
+	}
+	return "{" + strings.Join(values, ", ") + "}"
+}
@@ -0,0 +1,174 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestComputePlan(t *testing.T) {
+	current := testRegistryProto("v1")
+	current.FeatureViews[0].Spec.BatchSource = &core.DataSource{
+		Type:    core.DataSource_BATCH_FILE,
+		Options: &core.DataSource_FileOptions_{FileOptions: &core.DataSource_FileOptions{FileUrl: "s3://bucket/driver_stats.parquet"}},
+	}
+
+	tt := []struct {
+		name   string
+		update func(desired *core.Registry)
+		want   []ObjectChange
+	}{
+		{
+			name:   "No changes",
+			update: func(desired *core.Registry) {},
+			want:   []ObjectChange{},
+		},
+		{
+			name: "Metadata changes are ignored",
+			update: func(desired *core.Registry) {
+				desired.FeatureViews[0].Meta = &core.FeatureViewMeta{MaterializationIntervals: []*core.MaterializationInterval{{}}}
+			},
+			want: []ObjectChange{},
+		},
+		{
+			name: "Added and deleted objects",
+			update: func(desired *core.Registry) {
+				desired.Entities = append(desired.Entities, &core.Entity{Spec: &core.EntitySpecV2{Name: "vehicle", Project: "driver_project"}})
+				desired.FeatureServices = nil
+			},
+			want: []ObjectChange{
+				{Type: Added, Kind: KindEntity, Project: "driver_project", Name: "vehicle"},
+				{Type: Deleted, Kind: KindFeatureService, Project: "driver_project", Name: "driver_service"},
+			},
+		},
+		{
+			name: "Feature view field changes",
+			update: func(desired *core.Registry) {
+				spec := desired.FeatureViews[0].Spec
+				spec.Features = []*core.FeatureSpecV2{
+					{Name: "rating", ValueType: types.ValueType_INT64},
+					{Name: "acceptance_rate", ValueType: types.ValueType_FLOAT},
+				}
+				spec.Ttl = durationpb.New(2 * time.Hour)
+				spec.Tags = map[string]string{"team": "drivers", "owner": "jane"}
+				spec.BatchSource.GetFileOptions().FileUrl = "s3://bucket/driver_stats_v2.parquet"
+			},
+			want: []ObjectChange{{
+				Type: Updated, Kind: KindFeatureView, Project: "driver_project", Name: "driver_stats",
+				Fields: []FieldChange{
+					{Path: "features[trips]", Old: "{name: \"trips\", value_type: DOUBLE}"},
+					{Path: "features[rating].value_type", Old: "DOUBLE", New: "INT64", Destructive: "feature type change"},
+					{Path: "features[acceptance_rate]", New: "{name: \"acceptance_rate\", value_type: FLOAT}"},
+					{Path: "tags[owner]", New: "\"jane\""},
+					{Path: "ttl", Old: "1h0m0s", New: "2h0m0s"},
+					{Path: "batch_source.file_options.file_url", Old: "\"s3://bucket/driver_stats.parquet\"", New: "\"s3://bucket/driver_stats_v2.parquet\""},
+				},
+			}},
+		},
+		{
+			name: "Entity join key change",
+			update: func(desired *core.Registry) {
+				desired.Entities[0].Spec.JoinKey = "driver"
+				desired.Entities[0].Spec.Description = "Driver"
+			},
+			want: []ObjectChange{{
+				Type: Updated, Kind: KindEntity, Project: "driver_project", Name: "driver",
+				Fields: []FieldChange{
+					{Path: "description", Old: "\"\"", New: "\"Driver\""},
+					{Path: "join_key", Old: "\"driver_id\"", New: "\"driver\"", Destructive: "entity join key change"},
+				},
+			}},
+		},
+		{
+			name: "Feature service projection change",
+			update: func(desired *core.Registry) {
+				desired.FeatureServices[0].Spec.Features[0].FeatureColumns[0].ValueType = types.ValueType_FLOAT
+			},
+			want: []ObjectChange{{
+				Type: Updated, Kind: KindFeatureService, Project: "driver_project", Name: "driver_service",
+				Fields: []FieldChange{
+					{Path: "features[driver_stats].feature_columns[rating].value_type", Old: "DOUBLE", New: "FLOAT", Destructive: "feature type change"},
+				},
+			}},
+		},
+		{
+			name: "Feature table changes",
+			update: func(desired *core.Registry) {
+				desired.FeatureTables = append(desired.FeatureTables, &core.FeatureTable{
+					Spec: &core.FeatureTableSpec{Name: "driver_trips", Project: "driver_project", Entities: []string{"driver"}},
+				})
+			},
+			want: []ObjectChange{
+				{Type: Added, Kind: KindFeatureTable, Project: "driver_project", Name: "driver_trips"},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			desired := proto.Clone(current).(*core.Registry)
+			tc.update(desired)
+			plan := ComputePlan(current, desired)
+			if diff := cmp.Diff(tc.want, plan.Changes); diff != "" {
+				t.Errorf("Unexpected plan (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanOutput(t *testing.T) {
+	current := testRegistryProto("v1")
+	desired := testRegistryProto("v2")
+	desired.Entities[0].Spec.JoinKey = "driver"
+	desired.Entities = append(desired.Entities, &core.Entity{Spec: &core.EntitySpecV2{Name: "vehicle", Project: "driver_project"}})
+	desired.FeatureViews = desired.FeatureViews[:2]
+	plan := ComputePlan(current, desired)
+	if !plan.HasChanges() || !plan.Destructive() {
+		t.Errorf("Expected plan with destructive changes, got %+v", plan)
+	}
+
+	var text bytes.Buffer
+	if err := plan.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	wantText := strings.Join([]string{
+		"~ entity driver_project/driver",
+		"    ~ join_key: \"driver_id\" -> \"driver\" (destructive: entity join key change)",
+		"+ entity driver_project/vehicle",
+		"- feature view customer_project/customer_stats",
+		"",
+		"Plan: 1 to add, 1 to update, 1 to delete, 1 destructive.",
+		"",
+	}, "\n")
+	if diff := cmp.Diff(wantText, text.String()); diff != "" {
+		t.Errorf("Unexpected text output (-want +got):\n%s", diff)
+	}
+
+	var jsonOutput bytes.Buffer
+	if err := plan.WriteJSON(&jsonOutput); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Plan
+	if err := json.Unmarshal(jsonOutput.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(plan, &decoded); diff != "" {
+		t.Errorf("Unexpected JSON output (-want +got):\n%s", diff)
+	}
+
+	text.Reset()
+	if err := ComputePlan(current, current).WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	if text.String() != "No changes.\n" {
+		t.Errorf("Expected no changes, got %q", text.String())
+	}
+}
@@ -0,0 +1,282 @@
+// Package registry implements a read-only client for the Feast registry written by feature repos.
+package registry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+// NotFoundError indicates that a registry object does not exist in the given project.
+type NotFoundError struct {
+	// Kind of the object, ie. "feature view".
+	Kind    string
+	Project string
+	Name    string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s %s does not exist in project %s", e.Kind, e.Name, e.Project)
+}
+
+const (
+	// Timeout of the refreshes of the registry made on access.
+	defaultRefreshTimeout = 30 * time.Second
+	// Delay before the registry is loaded again after a failed refresh, doubling after each consecutive failure.
+	minRefreshBackoff = time.Second
+	// Maximum delay between attempts to load the registry after failed refreshes.
+	maxRefreshBackoff = 5 * time.Minute
+)
+
+// Registry is a read-only, in memory cached view of a serialized Registry proto.
+// The registry is loaded again on access once the refresh interval has elapsed. If loading the registry
+// fails, the last loaded registry keeps being served and loading is retried with exponential backoff.
+// As a registry is always loaded once created, accessors never fail to load it: the errors they return only report
+// objects that do not exist, as a *NotFoundError, and failures to load the registry are only reported by RefreshError.
+type Registry struct {
+	store           RegistryStore
+	refreshInterval time.Duration
+
+	mu       sync.RWMutex
+	snapshot *snapshot
+	// Time after which the registry is loaded again on access.
+	nextRefresh time.Time
+	// Whether the registry is being loaded on access, by a single caller.
+	refreshing bool
+	// Error of the last refresh, and the number of consecutive failed refreshes.
+	refreshErr error
+	failures   int
+	// Closed and replaced whenever a registry with a new version id is loaded, waking up watchers.
+	changed chan struct{}
+}
+
+// NewRegistry creates a Registry loading the serialized Registry proto (ie. registry.db) at the given path.
+// refreshInterval - interval after which the registry is loaded again. Disables refreshing if zero.
+// Returns an error if the registry cannot be loaded.
+func NewRegistry(path string, refreshInterval time.Duration) (*Registry, error) {
+	return NewRegistryFromStore(NewFileStore(path), refreshInterval)
+}
+
+// NewRegistryFromStore creates a Registry loading the Registry proto from the given store.
+// refreshInterval - interval after which the registry is loaded again. Disables refreshing if zero.
+// Returns an error if the registry cannot be loaded.
+func NewRegistryFromStore(store RegistryStore, refreshInterval time.Duration) (*Registry, error) {
+	registry := &Registry{store: store, refreshInterval: refreshInterval, changed: make(chan struct{})}
+	if err := registry.Refresh(context.Background()); err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
+// Refresh loads the registry again, replacing the cached registry. The cached registry is kept if loading fails,
+// in which case the error is also reported by RefreshError.
+func (r *Registry) Refresh(ctx context.Context) error {
+	registryProto, err := r.store.Get(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.refreshErr = err
+	if err != nil {
+		r.failures++
+		r.nextRefresh = time.Now().Add(r.refreshBackoff())
+		return err
+	}
+	r.failures = 0
+	if r.snapshot != nil && r.snapshot.proto.GetVersionId() != registryProto.GetVersionId() {
+		close(r.changed)
+		r.changed = make(chan struct{})
+	}
+	r.snapshot = newSnapshot(registryProto)
+	r.nextRefresh = time.Now().Add(r.refreshInterval)
+	return nil
+}
+
+// RefreshError returns the error of the last attempt to load the registry, nil if it succeeded.
+func (r *Registry) RefreshError() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.refreshErr
+}
+
+// Returns the delay before loading the registry again after the current number of consecutive failures,
+// at most the refresh interval.
+func (r *Registry) refreshBackoff() time.Duration {
+	backoff := minRefreshBackoff
+	for i := 1; i < r.failures && backoff < maxRefreshBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRefreshBackoff {
+		backoff = maxRefreshBackoff
+	}
+	if r.refreshInterval > 0 && backoff > r.refreshInterval {
+		backoff = r.refreshInterval
+	}
+	return backoff
+}
+
+// Returns the cached registry, refreshing it first if the refresh interval has elapsed. Callers accessing
+// the registry while it is being refreshed, or after the refresh failed, get the cached registry.
+func (r *Registry) current() *snapshot {
+	r.mu.RLock()
+	stale := r.refreshInterval > 0 && !r.refreshing && time.Now().After(r.nextRefresh)
+	current := r.snapshot
+	r.mu.RUnlock()
+	if !stale {
+		return current
+	}
+
+	r.mu.Lock()
+	if r.refreshing || !time.Now().After(r.nextRefresh) {
+		r.mu.Unlock()
+		return current
+	}
+	r.refreshing = true
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRefreshTimeout)
+	defer cancel()
+	// Errors are reported by RefreshError, the cached registry being served meanwhile.
+	r.Refresh(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.refreshing = false
+	return r.snapshot
+}
+
+// Proto returns the cached Registry proto. The returned proto must not be modified. The error is always nil, failures
+// to load the registry being reported by RefreshError.
+func (r *Registry) Proto() (*core.Registry, error) {
+	current := r.current()
+	return current.proto, nil
+}
+
+// VersionID returns the version id of the cached registry, which changes on each update of the registry.
+func (r *Registry) VersionID() (string, error) {
+	current := r.current()
+	return current.proto.GetVersionId(), nil
+}
+
+// LastUpdated returns the time the cached registry was last updated.
+func (r *Registry) LastUpdated() (time.Time, error) {
+	current := r.current()
+	if current.proto.GetLastUpdated() == nil {
+		return time.Time{}, nil
+	}
+	return current.proto.GetLastUpdated().AsTime(), nil
+}
+
+// Projects returns the names of the projects with objects in the registry, sorted by name.
+func (r *Registry) Projects() ([]string, error) {
+	current := r.current()
+	return current.projects(), nil
+}
+
+// GetEntity returns the entity with the given name in the given project.
+func (r *Registry) GetEntity(project string, name string) (*feast.Entity, error) {
+	current := r.current()
+	entity, ok := current.entities[objectKey{project, name}]
+	if !ok {
+		return nil, &NotFoundError{Kind: "entity", Project: project, Name: name}
+	}
+	return feast.NewEntityFromProto(entity), nil
+}
+
+// GetFeatureView returns the feature view with the given name in the given project.
+func (r *Registry) GetFeatureView(project string, name string) (*feast.FeatureView, error) {
+	current := r.current()
+	featureView, ok := current.featureViews[objectKey{project, name}]
+	if !ok {
+		return nil, &NotFoundError{Kind: "feature view", Project: project, Name: name}
+	}
+	return feast.NewFeatureViewFromProto(featureView), nil
+}
+
+// GetOnDemandFeatureView returns the on demand feature view with the given name in the given project.
+func (r *Registry) GetOnDemandFeatureView(project string, name string) (*feast.OnDemandFeatureView, error) {
+	current := r.current()
+	odfv, ok := current.onDemandFeatureViews[objectKey{project, name}]
+	if !ok {
+		return nil, &NotFoundError{Kind: "on demand feature view", Project: project, Name: name}
+	}
+	return feast.NewOnDemandFeatureViewFromProto(odfv), nil
+}
+
+// GetFeatureService returns the feature service with the given name in the given project.
+func (r *Registry) GetFeatureService(project string, name string) (*feast.FeatureService, error) {
+	current := r.current()
+	featureService, ok := current.featureServices[objectKey{project, name}]
+	if !ok {
+		return nil, &NotFoundError{Kind: "feature service", Project: project, Name: name}
+	}
+	return feast.NewFeatureServiceFromProto(featureService), nil
+}
+
+// ListEntities returns the entities of the given project, sorted by name.
+func (r *Registry) ListEntities(project string) ([]*feast.Entity, error) {
+	current := r.current()
+	var entities []*feast.Entity
+	for _, entity := range current.proto.GetEntities() {
+		if entity.GetSpec().GetProject() == project {
+			entities = append(entities, feast.NewEntityFromProto(entity))
+		}
+	}
+	sort.Slice(entities, func(i, j int) bool { return entities[i].Name() < entities[j].Name() })
+	return entities, nil
+}
+
+// ListFeatureViews returns the feature views of the given project carrying all of the given tags, sorted by name.
+func (r *Registry) ListFeatureViews(project string, tags map[string]string) ([]*feast.FeatureView, error) {
+	current := r.current()
+	var featureViews []*feast.FeatureView
+	for _, featureView := range current.proto.GetFeatureViews() {
+		spec := featureView.GetSpec()
+		if spec.GetProject() == project && hasTags(spec.GetTags(), tags) {
+			featureViews = append(featureViews, feast.NewFeatureViewFromProto(featureView))
+		}
+	}
+	sort.Slice(featureViews, func(i, j int) bool { return featureViews[i].Name() < featureViews[j].Name() })
+	return featureViews, nil
+}
+
+// ListOnDemandFeatureViews returns the on demand feature views of the given project, sorted by name.
+func (r *Registry) ListOnDemandFeatureViews(project string) ([]*feast.OnDemandFeatureView, error) {
+	current := r.current()
+	var odfvs []*feast.OnDemandFeatureView
+	for _, odfv := range current.proto.GetOnDemandFeatureViews() {
+		if odfv.GetSpec().GetProject() == project {
+			odfvs = append(odfvs, feast.NewOnDemandFeatureViewFromProto(odfv))
+		}
+	}
+	sort.Slice(odfvs, func(i, j int) bool { return odfvs[i].Name() < odfvs[j].Name() })
+	return odfvs, nil
+}
+
+// ListFeatureServices returns the feature services of the given project carrying all of the given tags, sorted by name.
+func (r *Registry) ListFeatureServices(project string, tags map[string]string) ([]*feast.FeatureService, error) {
+	current := r.current()
+	var featureServices []*feast.FeatureService
+	for _, featureService := range current.proto.GetFeatureServices() {
+		spec := featureService.GetSpec()
+		if spec.GetProject() == project && hasTags(spec.GetTags(), tags) {
+			featureServices = append(featureServices, feast.NewFeatureServiceFromProto(featureService))
+		}
+	}
+	sort.Slice(featureServices, func(i, j int) bool { return featureServices[i].Name() < featureServices[j].Name() })
+	return featureServices, nil
+}
+
+// Checks whether objectTags contains all of the wanted tags.
+func hasTags(objectTags map[string]string, wanted map[string]string) bool {
+	for key, value := range wanted {
+		if objectValue, ok := objectTags[key]; !ok || objectValue != value {
+			return false
+		}
+	}
+	return true
+}
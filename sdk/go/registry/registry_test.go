@@ -0,0 +1,246 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Returns a Registry proto with objects in the driver and customer projects for use in tests.
+func testRegistryProto(versionID string) *core.Registry {
+	featureView := func(project string, name string, entity string, tags map[string]string, features ...string) *core.FeatureView {
+		specs := make([]*core.FeatureSpecV2, len(features))
+		for i, feature := range features {
+			specs[i] = &core.FeatureSpecV2{Name: feature, ValueType: types.ValueType_DOUBLE}
+		}
+		return &core.FeatureView{Spec: &core.FeatureViewSpec{
+			Name:     name,
+			Project:  project,
+			Entities: []string{entity},
+			Features: specs,
+			Tags:     tags,
+			Ttl:      durationpb.New(time.Hour),
+		}}
+	}
+	return &core.Registry{
+		Entities: []*core.Entity{
+			{Spec: &core.EntitySpecV2{Name: "driver", Project: "driver_project", JoinKey: "driver_id", ValueType: types.ValueType_INT64}},
+			{Spec: &core.EntitySpecV2{Name: "customer", Project: "customer_project", JoinKey: "customer_id", ValueType: types.ValueType_STRING}},
+		},
+		FeatureViews: []*core.FeatureView{
+			featureView("driver_project", "driver_stats", "driver", map[string]string{"team": "drivers"}, "rating", "trips"),
+			featureView("driver_project", "driver_pii", "driver", map[string]string{"team": "drivers", "pii": "true"}, "name"),
+			featureView("customer_project", "customer_stats", "customer", nil, "orders"),
+		},
+		OnDemandFeatureViews: []*core.OnDemandFeatureView{
+			{Spec: &core.OnDemandFeatureViewSpec{Name: "driver_adjusted", Project: "driver_project"}},
+		},
+		FeatureServices: []*core.FeatureService{
+			{Spec: &core.FeatureServiceSpec{
+				Name:    "driver_service",
+				Project: "driver_project",
+				Features: []*core.FeatureViewProjection{{
+					FeatureViewName: "driver_stats",
+					FeatureColumns:  []*core.FeatureSpecV2{{Name: "rating", ValueType: types.ValueType_DOUBLE}},
+				}},
+			}},
+		},
+		VersionId:   versionID,
+		LastUpdated: timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+// Writes the given Registry proto to path.
+func writeRegistry(t *testing.T, path string, registry *core.Registry) {
+	registryBytes, err := proto.Marshal(registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, registryBytes, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Creates a temporary directory for a test, returning it and a function removing it.
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "feast-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func TestRegistryLookups(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "registry.db")
+	writeRegistry(t, path, testRegistryProto("v1"))
+
+	registry, err := NewRegistry(path, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error loading registry: %v", err)
+	}
+
+	entity, err := registry.GetEntity("driver_project", "driver")
+	if err != nil || entity.JoinKey() != "driver_id" {
+		t.Errorf("Unexpected entity %v or error %v", entity, err)
+	}
+	featureView, err := registry.GetFeatureView("driver_project", "driver_stats")
+	if err != nil || featureView.TTL() != time.Hour || len(featureView.Features()) != 2 {
+		t.Errorf("Unexpected feature view %v or error %v", featureView, err)
+	}
+	featureService, err := registry.GetFeatureService("driver_project", "driver_service")
+	if err != nil || !cmp.Equal(featureService.FeatureRefs(), []string{"driver_stats:rating"}) {
+		t.Errorf("Unexpected feature service %v or error %v", featureService, err)
+	}
+	if _, err := registry.GetOnDemandFeatureView("driver_project", "driver_adjusted"); err != nil {
+		t.Errorf("Unexpected error getting on demand feature view: %v", err)
+	}
+
+	// Objects are scoped by project.
+	_, err = registry.GetFeatureView("customer_project", "driver_stats")
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Errorf("Expected NotFoundError, got %v", err)
+	}
+
+	tt := []struct {
+		name    string
+		project string
+		tags    map[string]string
+		want    []string
+	}{
+		{name: "All feature views of project", project: "driver_project", want: []string{"driver_pii", "driver_stats"}},
+		{name: "Filtered by tag", project: "driver_project", tags: map[string]string{"pii": "true"}, want: []string{"driver_pii"}},
+		{name: "Filtered by tag value", project: "driver_project", tags: map[string]string{"team": "customers"}, want: nil},
+		{name: "Other project", project: "customer_project", want: []string{"customer_stats"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			featureViews, err := registry.ListFeatureViews(tc.project, tc.tags)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, featureView := range featureViews {
+				got = append(got, featureView.Name())
+			}
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf("Expected feature views %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	projects, _ := registry.Projects()
+	if !cmp.Equal(projects, []string{"customer_project", "driver_project"}) {
+		t.Errorf("Unexpected projects: %v", projects)
+	}
+}
+
+func TestRegistryRefresh(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "registry.db")
+	writeRegistry(t, path, testRegistryProto("v1"))
+
+	registry, err := NewRegistry(path, time.Nanosecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if versionID, _ := registry.VersionID(); versionID != "v1" {
+		t.Errorf("Expected version v1, got %s", versionID)
+	}
+	lastUpdated, _ := registry.LastUpdated()
+	if !lastUpdated.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected last updated time %v", lastUpdated)
+	}
+
+	updated := testRegistryProto("v2")
+	updated.FeatureViews = updated.FeatureViews[:1]
+	writeRegistry(t, path, updated)
+	time.Sleep(time.Millisecond)
+	if versionID, _ := registry.VersionID(); versionID != "v2" {
+		t.Errorf("Expected refreshed version v2, got %s", versionID)
+	}
+	if _, err := registry.GetFeatureView("driver_project", "driver_pii"); err == nil {
+		t.Error("Expected removed feature view to be gone after refresh")
+	}
+
+	// The cached registry is served when refreshing fails, with the error reported separately.
+	if err := ioutil.WriteFile(path, []byte("not a registry"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+	if versionID, err := registry.VersionID(); err != nil || versionID != "v2" {
+		t.Errorf("Expected cached version v2, got %s, %v", versionID, err)
+	}
+	if err := registry.RefreshError(); err == nil {
+		t.Error("Expected error refreshing corrupt registry")
+	}
+	writeRegistry(t, path, testRegistryProto("v3"))
+	time.Sleep(time.Millisecond)
+	if versionID, _ := registry.VersionID(); versionID != "v3" {
+		t.Errorf("Expected refreshed version v3, got %s", versionID)
+	}
+	if err := registry.RefreshError(); err != nil {
+		t.Errorf("Unexpected refresh error %v", err)
+	}
+}
+
+// failingStore is a RegistryStore counting the calls to Get, which fail once the registry was loaded.
+type failingStore struct {
+	RegistryStore
+	gets int
+}
+
+func (s *failingStore) Get(ctx context.Context) (*core.Registry, error) {
+	s.gets++
+	if s.gets > 1 {
+		return nil, errors.New("unavailable")
+	}
+	return testRegistryProto("v1"), nil
+}
+
+func TestRegistryRefreshBackoff(t *testing.T) {
+	store := &failingStore{}
+	registry, err := NewRegistryFromStore(store, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Failed refreshes are retried after a backoff, doubling after each consecutive failure.
+	for i, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		registry.nextRefresh = time.Now().Add(-time.Millisecond)
+		start := time.Now()
+		for j := 0; j < 2; j++ {
+			if _, err := registry.GetFeatureView("driver_project", "driver_stats"); err != nil {
+				t.Fatalf("Unexpected error reading the cached registry: %v", err)
+			}
+		}
+		if store.gets != i+2 {
+			t.Errorf("Expected the registry to be loaded %d times, got %d", i+2, store.gets)
+		}
+		if backoff := registry.nextRefresh.Sub(start); backoff < want || backoff > want+time.Second {
+			t.Errorf("Expected a backoff of %s, got %s", want, backoff)
+		}
+	}
+	if err := registry.RefreshError(); err == nil || err.Error() != "unavailable" {
+		t.Errorf("Expected refresh error unavailable, got %v", err)
+	}
+}
+
+func TestMissingRegistry(t *testing.T) {
+	if _, err := NewRegistry("does-not-exist.db", 0); err == nil {
+		t.Error("Expected error loading missing registry")
+	}
+}
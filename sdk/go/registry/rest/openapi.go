@@ -0,0 +1,240 @@
+package rest
+
+// OpenAPI 3.0 document of the API, served at /openapi.json. Registry objects are described loosely as they are
+// the proto-JSON encoding of the Feast core protos.
+const openAPIDocument = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "Feast Registry API",
+    "description": "Read-only API for browsing the objects of a Feast registry. Objects are encoded as proto-JSON.",
+    "version": "1.0.0"
+  },
+  "paths": {
+    "/projects": {
+      "get": {
+        "summary": "List projects",
+        "parameters": [
+          {"$ref": "#/components/parameters/PageSize"},
+          {"$ref": "#/components/parameters/PageToken"}
+        ],
+        "responses": {
+          "200": {"$ref": "#/components/responses/ProjectList"},
+          "304": {"$ref": "#/components/responses/NotModified"},
+          "400": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/entities": {
+      "get": {
+        "summary": "List entities",
+        "description": "Tag filters match entity labels.",
+        "parameters": [
+          {"$ref": "#/components/parameters/ProjectFilter"},
+          {"$ref": "#/components/parameters/Tag"},
+          {"$ref": "#/components/parameters/PageSize"},
+          {"$ref": "#/components/parameters/PageToken"}
+        ],
+        "responses": {
+          "200": {"$ref": "#/components/responses/EntityList"},
+          "304": {"$ref": "#/components/responses/NotModified"},
+          "400": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/entities/{name}": {
+      "get": {
+        "summary": "Get an entity",
+        "parameters": [
+          {"$ref": "#/components/parameters/Name"},
+          {"$ref": "#/components/parameters/Project"}
+        ],
+        "responses": {
+          "200": {"description": "The entity.", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Entity"}}}},
+          "304": {"$ref": "#/components/responses/NotModified"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/feature-views": {
+      "get": {
+        "summary": "List feature views",
+        "parameters": [
+          {"$ref": "#/components/parameters/ProjectFilter"},
+          {"$ref": "#/components/parameters/Tag"},
+          {"$ref": "#/components/parameters/PageSize"},
+          {"$ref": "#/components/parameters/PageToken"}
+        ],
+        "responses": {
+          "200": {"$ref": "#/components/responses/FeatureViewList"},
+          "304": {"$ref": "#/components/responses/NotModified"},
+          "400": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/feature-views/{name}": {
+      "get": {
+        "summary": "Get a feature view",
+        "parameters": [
+          {"$ref": "#/components/parameters/Name"},
+          {"$ref": "#/components/parameters/Project"}
+        ],
+        "responses": {
+          "200": {"description": "The feature view.", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/FeatureView"}}}},
+          "304": {"$ref": "#/components/responses/NotModified"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/feature-services": {
+      "get": {
+        "summary": "List feature services",
+        "parameters": [
+          {"$ref": "#/components/parameters/ProjectFilter"},
+          {"$ref": "#/components/parameters/Tag"},
+          {"$ref": "#/components/parameters/PageSize"},
+          {"$ref": "#/components/parameters/PageToken"}
+        ],
+        "responses": {
+          "200": {"$ref": "#/components/responses/FeatureServiceList"},
+          "304": {"$ref": "#/components/responses/NotModified"},
+          "400": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/feature-services/{name}": {
+      "get": {
+        "summary": "Get a feature service",
+        "parameters": [
+          {"$ref": "#/components/parameters/Name"},
+          {"$ref": "#/components/parameters/Project"}
+        ],
+        "responses": {
+          "200": {"description": "The feature service.", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/FeatureService"}}}},
+          "304": {"$ref": "#/components/responses/NotModified"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/data-sources": {
+      "get": {
+        "summary": "List data sources",
+        "description": "Lists the batch and stream sources of feature views, named by the data they read.",
+        "parameters": [
+          {"$ref": "#/components/parameters/ProjectFilter"},
+          {"$ref": "#/components/parameters/PageSize"},
+          {"$ref": "#/components/parameters/PageToken"}
+        ],
+        "responses": {
+          "200": {"$ref": "#/components/responses/DataSourceList"},
+          "304": {"$ref": "#/components/responses/NotModified"},
+          "400": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/data-sources/{name}": {
+      "get": {
+        "summary": "Get a data source",
+        "description": "The name of a data source must be URL encoded, ie. s3:%2F%2Fbucket%2Fdriver_stats.parquet.",
+        "parameters": [
+          {"$ref": "#/components/parameters/Name"},
+          {"$ref": "#/components/parameters/Project"}
+        ],
+        "responses": {
+          "200": {"description": "The data source.", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/DataSourceItem"}}}},
+          "304": {"$ref": "#/components/responses/NotModified"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    }
+  },
+  "components": {
+    "parameters": {
+      "Name": {"name": "name", "in": "path", "required": true, "schema": {"type": "string"}},
+      "Project": {"name": "project", "in": "query", "required": true, "schema": {"type": "string"}},
+      "ProjectFilter": {"name": "project", "in": "query", "description": "Only list objects of the given project.", "schema": {"type": "string"}},
+      "Tag": {
+        "name": "tag",
+        "in": "query",
+        "description": "Only list objects with the given tag, formatted as key:value. May be repeated.",
+        "schema": {"type": "array", "items": {"type": "string"}},
+        "style": "form",
+        "explode": true
+      },
+      "PageSize": {"name": "page_size", "in": "query", "schema": {"type": "integer", "minimum": 1, "maximum": 1000, "default": 100}},
+      "PageToken": {
+        "name": "page_token",
+        "in": "query",
+        "description": "nextPageToken of the previous page. Tokens expire when the registry is updated.",
+        "schema": {"type": "string"}
+      }
+    },
+    "headers": {
+      "ETag": {"description": "Version id of the registry.", "schema": {"type": "string"}}
+    },
+    "responses": {
+      "NotModified": {"description": "The registry has not changed since the version given by If-None-Match."},
+      "Error": {"description": "Error.", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}},
+      "ProjectList": {
+        "description": "A page of projects.",
+        "headers": {"ETag": {"$ref": "#/components/headers/ETag"}},
+        "content": {"application/json": {"schema": {"type": "object", "properties": {
+          "projects": {"type": "array", "items": {"$ref": "#/components/schemas/Project"}},
+          "nextPageToken": {"type": "string"}
+        }}}}
+      },
+      "EntityList": {
+        "description": "A page of entities.",
+        "headers": {"ETag": {"$ref": "#/components/headers/ETag"}},
+        "content": {"application/json": {"schema": {"type": "object", "properties": {
+          "entities": {"type": "array", "items": {"$ref": "#/components/schemas/Entity"}},
+          "nextPageToken": {"type": "string"}
+        }}}}
+      },
+      "FeatureViewList": {
+        "description": "A page of feature views.",
+        "headers": {"ETag": {"$ref": "#/components/headers/ETag"}},
+        "content": {"application/json": {"schema": {"type": "object", "properties": {
+          "featureViews": {"type": "array", "items": {"$ref": "#/components/schemas/FeatureView"}},
+          "nextPageToken": {"type": "string"}
+        }}}}
+      },
+      "FeatureServiceList": {
+        "description": "A page of feature services.",
+        "headers": {"ETag": {"$ref": "#/components/headers/ETag"}},
+        "content": {"application/json": {"schema": {"type": "object", "properties": {
+          "featureServices": {"type": "array", "items": {"$ref": "#/components/schemas/FeatureService"}},
+          "nextPageToken": {"type": "string"}
+        }}}}
+      },
+      "DataSourceList": {
+        "description": "A page of data sources.",
+        "headers": {"ETag": {"$ref": "#/components/headers/ETag"}},
+        "content": {"application/json": {"schema": {"type": "object", "properties": {
+          "dataSources": {"type": "array", "items": {"$ref": "#/components/schemas/DataSourceItem"}},
+          "nextPageToken": {"type": "string"}
+        }}}}
+      }
+    },
+    "schemas": {
+      "Error": {"type": "object", "properties": {"error": {"type": "string"}}},
+      "Project": {"type": "object", "properties": {"name": {"type": "string"}}},
+      "Entity": {"type": "object", "description": "feast.core.Entity", "properties": {"spec": {"type": "object"}, "meta": {"type": "object"}}},
+      "FeatureView": {"type": "object", "description": "feast.core.FeatureView", "properties": {"spec": {"type": "object"}, "meta": {"type": "object"}}},
+      "FeatureService": {"type": "object", "description": "feast.core.FeatureService", "properties": {"spec": {"type": "object"}, "meta": {"type": "object"}}},
+      "DataSourceItem": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "project": {"type": "string"},
+          "featureViews": {"type": "array", "items": {"type": "string"}},
+          "dataSource": {"type": "object", "description": "feast.core.DataSource"}
+        }
+      }
+    }
+  }
+}
+`
@@ -0,0 +1,395 @@
+// Package rest implements a read-only REST API for browsing a Feast registry.
+package rest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	feast "github.com/feast-dev/feast/sdk/go"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/registry"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// Number of items returned per page if no page size is requested.
+	defaultPageSize = 100
+	// Maximum number of items returned per page.
+	maxPageSize = 1000
+)
+
+// item is a registry object listed by a collection.
+type item struct {
+	project string
+	name    string
+	tags    map[string]string
+	// Returns the JSON representation of the object.
+	encode func() (json.RawMessage, error)
+}
+
+// collection is a kind of registry object exposed at /<path> and /<path>/<name>.
+type collection struct {
+	path string
+	// Key of the list of items in list responses.
+	key string
+	// Whether items are scoped to projects, requiring a project to get a single item.
+	projectScoped bool
+	items         func(registry *core.Registry) []item
+}
+
+// Server serves the registry objects of a registry client as JSON, with objects encoded as proto-JSON.
+//
+//	GET /projects                 lists the projects of the registry.
+//	GET /entities                 lists entities.
+//	GET /entities/{name}          returns the entity with the given name.
+//	GET /feature-views            lists feature views.
+//	GET /feature-views/{name}     returns the feature view with the given name.
+//	GET /feature-services         lists feature services.
+//	GET /feature-services/{name}  returns the feature service with the given name.
+//	GET /data-sources             lists the data sources of feature views.
+//	GET /data-sources/{name}      returns the data source with the given name.
+//	GET /openapi.json             returns the OpenAPI document of the API.
+//
+// Lists are filtered by the project and tag=key:value query parameters and paginated through the page_size
+// and page_token query parameters. Getting a single object requires the project query parameter.
+// Responses carry an ETag derived from the version id of the registry.
+type Server struct {
+	registry    *registry.Registry
+	collections map[string]collection
+}
+
+// NewServer creates a Server serving the objects of the given registry.
+func NewServer(registry *registry.Registry) *Server {
+	server := &Server{registry: registry, collections: make(map[string]collection)}
+	for _, c := range []collection{
+		{path: "projects", key: "projects", items: projectItems},
+		{path: "entities", key: "entities", projectScoped: true, items: entityItems},
+		{path: "feature-views", key: "featureViews", projectScoped: true, items: featureViewItems},
+		{path: "feature-services", key: "featureServices", projectScoped: true, items: featureServiceItems},
+		{path: "data-sources", key: "dataSources", projectScoped: true, items: dataSourceItems},
+	} {
+		server.collections[c.path] = c
+	}
+	return server
+}
+
+// httpError is an error response with a status code.
+type httpError struct {
+	status  int
+	message string
+}
+
+func (e *httpError) Error() string {
+	return e.message
+}
+
+func newHTTPError(status int, format string, args ...interface{}) *httpError {
+	return &httpError{status: status, message: fmt.Sprintf(format, args...)}
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeError(w, newHTTPError(http.StatusMethodNotAllowed, "method %s is not allowed", r.Method))
+		return
+	}
+	// Names may contain escaped slashes, ie. the names of data sources.
+	path := strings.Trim(r.URL.EscapedPath(), "/")
+	if path == "openapi.json" {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(openAPIDocument))
+		return
+	}
+	var err error
+	segments := strings.SplitN(path, "/", 2)
+	c, ok := s.collections[segments[0]]
+	if !ok || (len(segments) == 2 && (segments[1] == "" || strings.Contains(segments[1], "/") || c.path == "projects")) {
+		writeError(w, newHTTPError(http.StatusNotFound, "path %s does not exist", r.URL.Path))
+		return
+	}
+	var name string
+	if len(segments) == 2 {
+		if name, err = url.PathUnescape(segments[1]); err != nil {
+			writeError(w, newHTTPError(http.StatusBadRequest, "invalid name %q", segments[1]))
+			return
+		}
+	}
+
+	registryProto, err := s.registry.Proto()
+	if err != nil {
+		writeError(w, newHTTPError(http.StatusServiceUnavailable, "failed to load registry: %v", err))
+		return
+	}
+	// Objects are resolved before checking the ETag, so that missing objects and invalid requests are not reported
+	// as not modified.
+	var response interface{}
+	if len(segments) == 2 {
+		response, err = getItem(c, registryProto, name, r)
+	} else {
+		response, err = listItems(c, registryProto, r)
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if versionID := registryProto.GetVersionId(); versionID != "" {
+		etag := strconv.Quote(versionID)
+		w.Header().Set("ETag", etag)
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// Returns the single item of the collection with the given name in the requested project.
+func getItem(c collection, registryProto *core.Registry, name string, r *http.Request) (interface{}, error) {
+	project := r.URL.Query().Get("project")
+	if c.projectScoped && project == "" {
+		return nil, newHTTPError(http.StatusBadRequest, "project query parameter is required")
+	}
+	for _, item := range c.items(registryProto) {
+		if item.project == project && item.name == name {
+			return item.encode()
+		}
+	}
+	return nil, newHTTPError(http.StatusNotFound, "%s %s does not exist in project %s", strings.TrimSuffix(c.path, "s"), name, project)
+}
+
+// Returns a page of the items of the collection matching the request's filters.
+func listItems(c collection, registryProto *core.Registry, r *http.Request) (interface{}, error) {
+	query := r.URL.Query()
+	project := query.Get("project")
+	tags := make(map[string]string)
+	for _, tag := range query["tag"] {
+		parts := strings.SplitN(tag, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, newHTTPError(http.StatusBadRequest, "invalid tag filter %q, expected key:value", tag)
+		}
+		tags[parts[0]] = parts[1]
+	}
+	pageSize := defaultPageSize
+	if value := query.Get("page_size"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size <= 0 {
+			return nil, newHTTPError(http.StatusBadRequest, "invalid page size %q", value)
+		}
+		if size < maxPageSize {
+			pageSize = size
+		} else {
+			pageSize = maxPageSize
+		}
+	}
+	offset, err := decodePageToken(query.Get("page_token"), registryProto.GetVersionId())
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []item
+	for _, item := range c.items(registryProto) {
+		if (project == "" || item.project == project) && hasTags(item.tags, tags) {
+			matching = append(matching, item)
+		}
+	}
+	sort.SliceStable(matching, func(i, j int) bool {
+		if matching[i].project != matching[j].project {
+			return matching[i].project < matching[j].project
+		}
+		return matching[i].name < matching[j].name
+	})
+
+	page := make([]json.RawMessage, 0, pageSize)
+	for i := offset; i < len(matching) && len(page) < pageSize; i++ {
+		encoded, err := matching[i].encode()
+		if err != nil {
+			return nil, err
+		}
+		page = append(page, encoded)
+	}
+	response := map[string]interface{}{c.key: page}
+	if next := offset + len(page); next < len(matching) {
+		response["nextPageToken"] = encodePageToken(next, registryProto.GetVersionId())
+	}
+	return response, nil
+}
+
+// Page tokens encode the offset of the next page and the version of the registry it was computed for.
+func encodePageToken(offset int, versionID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s", offset, versionID)))
+}
+
+// Decodes a page token into an offset, returning an error if the registry changed since it was issued.
+func decodePageToken(token string, versionID string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, newHTTPError(http.StatusBadRequest, "invalid page token")
+	}
+	parts := strings.SplitN(string(decoded), ":", 2)
+	offset, err := strconv.Atoi(parts[0])
+	if err != nil || offset < 0 || len(parts) != 2 {
+		return 0, newHTTPError(http.StatusBadRequest, "invalid page token")
+	}
+	if parts[1] != versionID {
+		return 0, newHTTPError(http.StatusBadRequest, "page token has expired, the registry has been updated")
+	}
+	return offset, nil
+}
+
+// Reports whether the If-None-Match header value matches the given entity tag.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// Reports whether the given tags contain all of the wanted tags.
+func hasTags(tags map[string]string, wanted map[string]string) bool {
+	for key, value := range wanted {
+		if tagValue, ok := tags[key]; !ok || tagValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, response interface{}) {
+	body, err := json.Marshal(response)
+	if err != nil {
+		status = http.StatusInternalServerError
+		body, _ = json.Marshal(map[string]string{"error": err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if httpErr, ok := err.(*httpError); ok {
+		status = httpErr.status
+	}
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// Returns a function encoding the given proto as proto-JSON.
+func encodeProto(message proto.Message) func() (json.RawMessage, error) {
+	return func() (json.RawMessage, error) {
+		return protojson.Marshal(proto.MessageV2(message))
+	}
+}
+
+func projectItems(registryProto *core.Registry) []item {
+	seen := make(map[string]bool)
+	var items []item
+	add := func(project string) {
+		if !seen[project] {
+			seen[project] = true
+			items = append(items, item{project: project, name: project, encode: func() (json.RawMessage, error) {
+				return json.Marshal(map[string]string{"name": project})
+			}})
+		}
+	}
+	for _, entity := range registryProto.GetEntities() {
+		add(entity.GetSpec().GetProject())
+	}
+	for _, featureView := range registryProto.GetFeatureViews() {
+		add(featureView.GetSpec().GetProject())
+	}
+	for _, odfv := range registryProto.GetOnDemandFeatureViews() {
+		add(odfv.GetSpec().GetProject())
+	}
+	for _, featureService := range registryProto.GetFeatureServices() {
+		add(featureService.GetSpec().GetProject())
+	}
+	return items
+}
+
+func entityItems(registryProto *core.Registry) []item {
+	var items []item
+	for _, entity := range registryProto.GetEntities() {
+		spec := entity.GetSpec()
+		items = append(items, item{project: spec.GetProject(), name: spec.GetName(), tags: spec.GetLabels(), encode: encodeProto(entity)})
+	}
+	return items
+}
+
+func featureViewItems(registryProto *core.Registry) []item {
+	var items []item
+	for _, featureView := range registryProto.GetFeatureViews() {
+		spec := featureView.GetSpec()
+		items = append(items, item{project: spec.GetProject(), name: spec.GetName(), tags: spec.GetTags(), encode: encodeProto(featureView)})
+	}
+	return items
+}
+
+func featureServiceItems(registryProto *core.Registry) []item {
+	var items []item
+	for _, featureService := range registryProto.GetFeatureServices() {
+		spec := featureService.GetSpec()
+		items = append(items, item{project: spec.GetProject(), name: spec.GetName(), tags: spec.GetTags(), encode: encodeProto(featureService)})
+	}
+	return items
+}
+
+// Data sources are embedded in feature views rather than registered by name, so they are listed per project,
+// named by the data they read (see feast.DataSourceName), along with the feature views reading them.
+func dataSourceItems(registryProto *core.Registry) []item {
+	type dataSource struct {
+		project      string
+		name         string
+		source       *core.DataSource
+		featureViews []string
+	}
+	var sources []*dataSource
+	byKey := make(map[string]*dataSource)
+	for _, featureView := range registryProto.GetFeatureViews() {
+		spec := featureView.GetSpec()
+		for _, source := range []*core.DataSource{spec.GetBatchSource(), spec.GetStreamSource()} {
+			name := feast.DataSourceName(source)
+			if source == nil || name == "" {
+				continue
+			}
+			key := spec.GetProject() + "/" + name
+			if byKey[key] == nil {
+				byKey[key] = &dataSource{project: spec.GetProject(), name: name, source: source}
+				sources = append(sources, byKey[key])
+			}
+			byKey[key].featureViews = append(byKey[key].featureViews, spec.GetName())
+		}
+	}
+
+	items := make([]item, len(sources))
+	for i, source := range sources {
+		source := source
+		sort.Strings(source.featureViews)
+		items[i] = item{project: source.project, name: source.name, encode: func() (json.RawMessage, error) {
+			sourceJSON, err := protojson.Marshal(proto.MessageV2(source.source))
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(map[string]interface{}{
+				"name":         source.name,
+				"project":      source.project,
+				"featureViews": source.featureViews,
+				"dataSource":   json.RawMessage(sourceJSON),
+			})
+		}}
+	}
+	return items
+}
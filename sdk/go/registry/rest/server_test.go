@@ -0,0 +1,320 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/feast-dev/feast/sdk/go/registry"
+	"github.com/google/go-cmp/cmp"
+)
+
+func fileSource(path string) *core.DataSource {
+	return &core.DataSource{
+		Type:    core.DataSource_BATCH_FILE,
+		Options: &core.DataSource_FileOptions_{FileOptions: &core.DataSource_FileOptions{FileUrl: path}},
+	}
+}
+
+func featureView(project string, name string, source string, tags map[string]string) *core.FeatureView {
+	return &core.FeatureView{Spec: &core.FeatureViewSpec{
+		Name:        name,
+		Project:     project,
+		Tags:        tags,
+		Features:    []*core.FeatureSpecV2{{Name: "rating", ValueType: types.ValueType_DOUBLE}},
+		BatchSource: fileSource(source),
+	}}
+}
+
+// Starts a server for a registry stored in a temporary file, returning the server and the registry store.
+func newTestServer(t *testing.T) (*httptest.Server, registry.RegistryStore, func()) {
+	dir, err := ioutil.TempDir("", "feast-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := registry.NewFileStore(filepath.Join(dir, "registry.db"))
+	registryProto := &core.Registry{
+		Entities: []*core.Entity{
+			{Spec: &core.EntitySpecV2{Name: "driver", Project: "driver_project", Labels: map[string]string{"team": "drivers"}}},
+			{Spec: &core.EntitySpecV2{Name: "customer", Project: "customer_project"}},
+		},
+		FeatureViews: []*core.FeatureView{
+			featureView("driver_project", "driver_stats", "s3://bucket/driver_stats.parquet", map[string]string{"team": "drivers"}),
+			featureView("driver_project", "driver_ratings", "s3://bucket/driver_stats.parquet", nil),
+			featureView("driver_project", "driver_trips", "s3://bucket/driver_trips.parquet", map[string]string{"team": "drivers"}),
+			featureView("customer_project", "customer_stats", "customer_stats.parquet", nil),
+		},
+		FeatureServices: []*core.FeatureService{
+			{Spec: &core.FeatureServiceSpec{Name: "driver_service", Project: "driver_project"}},
+		},
+	}
+	if _, err := store.Put(context.Background(), registryProto, ""); err != nil {
+		t.Fatal(err)
+	}
+	reg, err := registry.NewRegistryFromStore(store, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(NewServer(reg))
+	return srv, store, func() {
+		srv.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// Gets the path from the server, decoding the JSON response into a generic map.
+func get(t *testing.T, srv *httptest.Server, path string) (int, map[string]interface{}) {
+	resp, err := http.Get(srv.URL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var body map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("Failed to decode response of %s: %v", path, err)
+	}
+	return resp.StatusCode, body
+}
+
+// Returns the names of the listed items under the given key of a list response.
+func names(body map[string]interface{}, key string) []string {
+	var names []string
+	items, _ := body[key].([]interface{})
+	for _, item := range items {
+		fields := item.(map[string]interface{})
+		if spec, ok := fields["spec"].(map[string]interface{}); ok {
+			fields = spec
+		}
+		name := fields["name"].(string)
+		if project, ok := fields["project"].(string); ok {
+			name = project + "/" + name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestServerLists(t *testing.T) {
+	srv, _, cleanup := newTestServer(t)
+	defer cleanup()
+
+	tt := []struct {
+		name string
+		path string
+		key  string
+		want []string
+	}{
+		{
+			name: "Projects",
+			path: "/projects",
+			key:  "projects",
+			want: []string{"customer_project", "driver_project"},
+		},
+		{
+			name: "Entities",
+			path: "/entities",
+			key:  "entities",
+			want: []string{"customer_project/customer", "driver_project/driver"},
+		},
+		{
+			name: "Entities by label",
+			path: "/entities?tag=team:drivers",
+			key:  "entities",
+			want: []string{"driver_project/driver"},
+		},
+		{
+			name: "Feature views of project",
+			path: "/feature-views?project=driver_project",
+			key:  "featureViews",
+			want: []string{"driver_project/driver_ratings", "driver_project/driver_stats", "driver_project/driver_trips"},
+		},
+		{
+			name: "Feature views by tag",
+			path: "/feature-views?tag=team:drivers&project=driver_project",
+			key:  "featureViews",
+			want: []string{"driver_project/driver_stats", "driver_project/driver_trips"},
+		},
+		{
+			name: "Feature services",
+			path: "/feature-services",
+			key:  "featureServices",
+			want: []string{"driver_project/driver_service"},
+		},
+		{
+			name: "Data sources",
+			path: "/data-sources",
+			key:  "dataSources",
+			want: []string{
+				"customer_project/customer_stats.parquet",
+				"driver_project/s3://bucket/driver_stats.parquet",
+				"driver_project/s3://bucket/driver_trips.parquet",
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			status, body := get(t, srv, tc.path)
+			if status != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d: %v", status, body)
+			}
+			if diff := cmp.Diff(tc.want, names(body, tc.key)); diff != "" {
+				t.Errorf("Unexpected items (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServerGet(t *testing.T) {
+	srv, _, cleanup := newTestServer(t)
+	defer cleanup()
+
+	tt := []struct {
+		name       string
+		path       string
+		wantStatus int
+	}{
+		{name: "Feature view", path: "/feature-views/driver_stats?project=driver_project", wantStatus: http.StatusOK},
+		{name: "Data source", path: "/data-sources/s3:%2F%2Fbucket%2Fdriver_stats.parquet?project=driver_project", wantStatus: http.StatusOK},
+		{name: "Missing project", path: "/feature-views/driver_stats", wantStatus: http.StatusBadRequest},
+		{name: "Not found", path: "/entities/vehicle?project=driver_project", wantStatus: http.StatusNotFound},
+		{name: "Unknown collection", path: "/vehicles", wantStatus: http.StatusNotFound},
+		{name: "Invalid tag", path: "/feature-views?tag=team", wantStatus: http.StatusBadRequest},
+		{name: "Invalid page size", path: "/feature-views?page_size=0", wantStatus: http.StatusBadRequest},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			status, body := get(t, srv, tc.path)
+			if status != tc.wantStatus {
+				t.Errorf("Expected status %d, got %d: %v", tc.wantStatus, status, body)
+			}
+		})
+	}
+
+	_, body := get(t, srv, "/data-sources/s3:%2F%2Fbucket%2Fdriver_stats.parquet?project=driver_project")
+	if diff := cmp.Diff([]interface{}{"driver_ratings", "driver_stats"}, body["featureViews"]); diff != "" {
+		t.Errorf("Unexpected feature views of data source (-want +got):\n%s", diff)
+	}
+}
+
+func TestServerPagination(t *testing.T) {
+	srv, store, cleanup := newTestServer(t)
+	defer cleanup()
+
+	var got []string
+	path := "/feature-views?page_size=3"
+	for pages := 0; path != ""; pages++ {
+		if pages > 2 {
+			t.Fatal("Expected pagination to end")
+		}
+		status, body := get(t, srv, path)
+		if status != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %v", status, body)
+		}
+		got = append(got, names(body, "featureViews")...)
+		path = ""
+		if token, ok := body["nextPageToken"].(string); ok {
+			path = "/feature-views?page_size=3&page_token=" + token
+		}
+	}
+	want := []string{"customer_project/customer_stats", "driver_project/driver_ratings", "driver_project/driver_stats", "driver_project/driver_trips"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected feature views (-want +got):\n%s", diff)
+	}
+
+	// Tokens are invalidated by updates of the registry.
+	_, body := get(t, srv, "/feature-views?page_size=3")
+	token := body["nextPageToken"].(string)
+	registryProto, err := store.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Put(context.Background(), registryProto, registryProto.GetVersionId()); err != nil {
+		t.Fatal(err)
+	}
+	reg, err := registry.NewRegistryFromStore(store, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated := httptest.NewServer(NewServer(reg))
+	defer updated.Close()
+	if status, body := get(t, updated, "/feature-views?page_size=3&page_token="+token); status != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for expired token, got %d: %v", status, body)
+	}
+}
+
+func TestServerETag(t *testing.T) {
+	srv, store, cleanup := newTestServer(t)
+	defer cleanup()
+
+	registryProto, err := store.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Get(srv.URL + "/entities")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	etag := resp.Header.Get("ETag")
+	if want := `"` + registryProto.GetVersionId() + `"`; etag != want {
+		t.Errorf("Expected ETag %s, got %s", want, etag)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/entities", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("If-None-Match", etag)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Expected status 304, got %d", resp.StatusCode)
+	}
+
+	// Unknown objects are not found, whatever their ETag.
+	req, err = http.NewRequest(http.MethodGet, srv.URL+"/entities/unknown?project=driver_project", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("If-None-Match", etag)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", resp.StatusCode)
+	}
+
+	resp, err = http.Post(srv.URL+"/entities", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405, got %d", resp.StatusCode)
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(openAPIDocument), &document); err != nil {
+		t.Fatalf("Invalid OpenAPI document: %v", err)
+	}
+	srv, _, cleanup := newTestServer(t)
+	defer cleanup()
+	status, body := get(t, srv, "/openapi.json")
+	if status != http.StatusOK || body["openapi"] != "3.0.3" {
+		t.Errorf("Unexpected OpenAPI response %d: %v", status, body["openapi"])
+	}
+}
@@ -0,0 +1,252 @@
+package registry
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+)
+
+// KindFeature is the kind of search results for features of feature views and on demand feature views.
+const KindFeature ObjectKind = "feature"
+
+// OwnerTag is the tag (or entity label) holding the owner of a registry object.
+const OwnerTag = "owner"
+
+// Scores of the ways a search term can match a document.
+const (
+	scoreExactName  = 100
+	scoreNamePrefix = 20
+	scoreNameToken  = 10
+	scoreName       = 5
+	scoreTag        = 3
+	scoreText       = 2
+)
+
+// SearchQuery selects registry objects to search for.
+type SearchQuery struct {
+	// Text is matched against the names, descriptions and tags of objects. All whitespace separated
+	// terms must match. Matches all objects if empty.
+	Text string
+	// Projects restricts results to the given projects if not empty.
+	Projects []string
+	// Kinds restricts results to the given kinds of objects if not empty, ie. KindFeature.
+	Kinds []ObjectKind
+	// ValueTypes restricts results to entities and features of the given value types if not empty.
+	ValueTypes []types.ValueType_Enum
+	// Owner restricts results to objects with the given OwnerTag tag if not empty.
+	// Features are owned by the owner of their feature view unless labeled otherwise.
+	Owner string
+	// Limit caps the number of results returned if positive.
+	Limit int
+}
+
+// SearchResult is a registry object matching a search query.
+type SearchResult struct {
+	Kind    ObjectKind
+	Project string
+	Name    string
+	// FeatureView is the name of the feature view defining the feature, set for features only.
+	FeatureView string
+	Description string
+	// Tags of the object, or labels of entities and features.
+	Tags map[string]string
+	// ValueType of the entity or feature, INVALID for other objects.
+	ValueType types.ValueType_Enum
+	// Score ranks results by how well they match the query text, highest first.
+	Score int
+}
+
+// Search returns the registry objects matching the given query, ranked by how well they match the query text.
+// Objects whose name exactly matches the query text are ranked first. Features are also matched on their
+// reference, ie. "driver_stats:rating". Results of equal score are ordered by kind, project and name.
+func (r *Registry) Search(query SearchQuery) ([]SearchResult, error) {
+	current := r.current()
+
+	terms := strings.Fields(strings.ToLower(query.Text))
+	fullText := strings.ToLower(strings.TrimSpace(query.Text))
+	var results []SearchResult
+	for _, result := range searchDocuments(current.proto) {
+		if !query.matchesFilters(result) {
+			continue
+		}
+		score, ok := scoreResult(result, terms, fullText)
+		if !ok {
+			continue
+		}
+		result.Score = score
+		results = append(results, result)
+	}
+
+	kindOrder := map[ObjectKind]int{KindFeature: len(objectKinds)}
+	for i, kind := range objectKinds {
+		kindOrder[kind] = i
+	}
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		switch {
+		case a.Score != b.Score:
+			return a.Score > b.Score
+		case a.Kind != b.Kind:
+			return kindOrder[a.Kind] < kindOrder[b.Kind]
+		case a.Project != b.Project:
+			return a.Project < b.Project
+		case a.FeatureView != b.FeatureView:
+			return a.FeatureView < b.FeatureView
+		default:
+			return a.Name < b.Name
+		}
+	})
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+	return results, nil
+}
+
+// Reports whether the result passes the project, kind, value type and owner filters of the query.
+func (query SearchQuery) matchesFilters(result SearchResult) bool {
+	if len(query.Projects) > 0 && !containsString(query.Projects, result.Project) {
+		return false
+	}
+	if len(query.Kinds) > 0 {
+		found := false
+		for _, kind := range query.Kinds {
+			found = found || kind == result.Kind
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(query.ValueTypes) > 0 {
+		found := false
+		for _, valueType := range query.ValueTypes {
+			found = found || valueType == result.ValueType
+		}
+		if !found {
+			return false
+		}
+	}
+	return query.Owner == "" || result.Tags[OwnerTag] == query.Owner
+}
+
+// Scores the result against the query terms, reporting whether all terms match.
+func scoreResult(result SearchResult, terms []string, fullText string) (int, bool) {
+	name := strings.ToLower(result.Name)
+	if fullText != "" && (fullText == name || (result.Kind == KindFeature && fullText == strings.ToLower(result.FeatureView+":"+result.Name))) {
+		return scoreExactName, true
+	}
+	score := 0
+	if fullText != "" && strings.HasPrefix(name, fullText) {
+		score += scoreNamePrefix
+	}
+
+	nameTokens := tokenize(name)
+	for _, term := range terms {
+		termScore := 0
+		switch {
+		case containsString(nameTokens, term):
+			termScore = scoreNameToken
+		case strings.Contains(name, term):
+			termScore = scoreName
+		}
+		for key, value := range result.Tags {
+			if termScore < scoreTag && (strings.Contains(strings.ToLower(key), term) || strings.Contains(strings.ToLower(value), term)) {
+				termScore = scoreTag
+			}
+		}
+		if termScore < scoreText && (strings.Contains(strings.ToLower(result.Description), term) ||
+			strings.Contains(strings.ToLower(result.FeatureView), term)) {
+			termScore = scoreText
+		}
+		if termScore == 0 {
+			return 0, false
+		}
+		score += termScore
+	}
+	return score, true
+}
+
+// Splits text into lower case words separated by any characters other than letters and digits.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the searchable objects of the registry: entities, feature views and their features,
+// on demand feature views and their features, and feature services.
+func searchDocuments(registry *core.Registry) []SearchResult {
+	var documents []SearchResult
+	addFeatures := func(project string, featureView string, owner string, features []*core.FeatureSpecV2) {
+		for _, feature := range features {
+			tags := feature.GetLabels()
+			if _, ok := tags[OwnerTag]; !ok && owner != "" {
+				tags = map[string]string{OwnerTag: owner}
+				for key, value := range feature.GetLabels() {
+					tags[key] = value
+				}
+			}
+			documents = append(documents, SearchResult{
+				Kind:        KindFeature,
+				Project:     project,
+				Name:        feature.GetName(),
+				FeatureView: featureView,
+				Tags:        tags,
+				ValueType:   feature.GetValueType(),
+			})
+		}
+	}
+
+	for _, entity := range registry.GetEntities() {
+		spec := entity.GetSpec()
+		documents = append(documents, SearchResult{
+			Kind:        KindEntity,
+			Project:     spec.GetProject(),
+			Name:        spec.GetName(),
+			Description: spec.GetDescription(),
+			Tags:        spec.GetLabels(),
+			ValueType:   spec.GetValueType(),
+		})
+	}
+	for _, featureView := range registry.GetFeatureViews() {
+		spec := featureView.GetSpec()
+		documents = append(documents, SearchResult{
+			Kind:    KindFeatureView,
+			Project: spec.GetProject(),
+			Name:    spec.GetName(),
+			Tags:    spec.GetTags(),
+		})
+		addFeatures(spec.GetProject(), spec.GetName(), spec.GetTags()[OwnerTag], spec.GetFeatures())
+	}
+	for _, odfv := range registry.GetOnDemandFeatureViews() {
+		spec := odfv.GetSpec()
+		documents = append(documents, SearchResult{
+			Kind:    KindOnDemandFeatureView,
+			Project: spec.GetProject(),
+			Name:    spec.GetName(),
+		})
+		addFeatures(spec.GetProject(), spec.GetName(), "", spec.GetFeatures())
+	}
+	for _, featureService := range registry.GetFeatureServices() {
+		spec := featureService.GetSpec()
+		documents = append(documents, SearchResult{
+			Kind:        KindFeatureService,
+			Project:     spec.GetProject(),
+			Name:        spec.GetName(),
+			Description: spec.GetDescription(),
+			Tags:        spec.GetTags(),
+		})
+	}
+	return documents
+}
@@ -0,0 +1,112 @@
+package registry
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegistrySearch(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "registry.db")
+	registryProto := testRegistryProto("v1")
+	registryProto.Entities[0].Spec.Description = "A driver delivering orders"
+	registryProto.FeatureViews[0].Spec.Tags[OwnerTag] = "jane"
+	registryProto.FeatureViews[2].Spec.Features = append(registryProto.FeatureViews[2].Spec.Features,
+		&core.FeatureSpecV2{Name: "driver_rating", ValueType: types.ValueType_FLOAT, Labels: map[string]string{OwnerTag: "joe"}})
+	registryProto.FeatureServices[0].Spec.Description = "Serves driver ratings"
+	writeRegistry(t, path, registryProto)
+	registry, err := NewRegistry(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name  string
+		query SearchQuery
+		want  []string
+	}{
+		{
+			name:  "Exact name matches first",
+			query: SearchQuery{Text: "driver"},
+			want: []string{
+				"entity driver_project/driver",
+				"feature view driver_project/driver_pii",
+				"feature view driver_project/driver_stats",
+				"on demand feature view driver_project/driver_adjusted",
+				"feature service driver_project/driver_service",
+				"feature customer_project/customer_stats:driver_rating",
+				// Features of matching feature views rank below features matching by name.
+				"feature driver_project/driver_pii:name",
+				"feature driver_project/driver_stats:rating",
+				"feature driver_project/driver_stats:trips",
+			},
+		},
+		{
+			name:  "Feature reference",
+			query: SearchQuery{Text: "driver_stats:rating"},
+			want:  []string{"feature driver_project/driver_stats:rating"},
+		},
+		{
+			name:  "All terms must match",
+			query: SearchQuery{Text: "driver rating"},
+			want: []string{
+				"feature customer_project/customer_stats:driver_rating",
+				"feature service driver_project/driver_service",
+				"feature driver_project/driver_stats:rating",
+			},
+		},
+		{
+			name:  "Tag values",
+			query: SearchQuery{Text: "drivers", Kinds: []ObjectKind{KindFeatureView}},
+			want:  []string{"feature view driver_project/driver_pii", "feature view driver_project/driver_stats"},
+		},
+		{
+			name:  "Project and value type filters",
+			query: SearchQuery{Projects: []string{"customer_project"}, ValueTypes: []types.ValueType_Enum{types.ValueType_FLOAT, types.ValueType_STRING}},
+			want:  []string{"entity customer_project/customer", "feature customer_project/customer_stats:driver_rating"},
+		},
+		{
+			name:  "Owner filter",
+			query: SearchQuery{Owner: "jane"},
+			want: []string{
+				"feature view driver_project/driver_stats",
+				"feature driver_project/driver_stats:rating",
+				"feature driver_project/driver_stats:trips",
+			},
+		},
+		{
+			name:  "Limit",
+			query: SearchQuery{Text: "driver", Limit: 1},
+			want:  []string{"entity driver_project/driver"},
+		},
+		{
+			name:  "No matches",
+			query: SearchQuery{Text: "vehicle"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := registry.Search(tc.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, result := range results {
+				name := result.Name
+				if result.Kind == KindFeature {
+					name = result.FeatureView + ":" + name
+				}
+				got = append(got, string(result.Kind)+" "+result.Project+"/"+name)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
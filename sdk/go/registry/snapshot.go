@@ -0,0 +1,120 @@
+package registry
+
+import (
+	"sort"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/golang/protobuf/proto"
+)
+
+// objectKey identifies a registry object by project and name.
+type objectKey struct {
+	project string
+	name    string
+}
+
+// snapshot is an immutable, indexed view of a Registry proto.
+type snapshot struct {
+	proto                *core.Registry
+	entities             map[objectKey]*core.Entity
+	featureViews         map[objectKey]*core.FeatureView
+	onDemandFeatureViews map[objectKey]*core.OnDemandFeatureView
+	featureServices      map[objectKey]*core.FeatureService
+}
+
+// Indexes the objects of the given Registry proto by project and name.
+func newSnapshot(registry *core.Registry) *snapshot {
+	s := &snapshot{
+		proto:                registry,
+		entities:             make(map[objectKey]*core.Entity),
+		featureViews:         make(map[objectKey]*core.FeatureView),
+		onDemandFeatureViews: make(map[objectKey]*core.OnDemandFeatureView),
+		featureServices:      make(map[objectKey]*core.FeatureService),
+	}
+	for _, entity := range registry.GetEntities() {
+		s.entities[objectKey{entity.GetSpec().GetProject(), entity.GetSpec().GetName()}] = entity
+	}
+	for _, featureView := range registry.GetFeatureViews() {
+		s.featureViews[objectKey{featureView.GetSpec().GetProject(), featureView.GetSpec().GetName()}] = featureView
+	}
+	for _, odfv := range registry.GetOnDemandFeatureViews() {
+		s.onDemandFeatureViews[objectKey{odfv.GetSpec().GetProject(), odfv.GetSpec().GetName()}] = odfv
+	}
+	for _, featureService := range registry.GetFeatureServices() {
+		s.featureServices[objectKey{featureService.GetSpec().GetProject(), featureService.GetSpec().GetName()}] = featureService
+	}
+	return s
+}
+
+// Returns the names of the projects with objects in the snapshot, sorted by name.
+func (s *snapshot) projects() []string {
+	seen := make(map[string]struct{})
+	for key := range s.entities {
+		seen[key.project] = struct{}{}
+	}
+	for key := range s.featureViews {
+		seen[key.project] = struct{}{}
+	}
+	for key := range s.onDemandFeatureViews {
+		seen[key.project] = struct{}{}
+	}
+	for key := range s.featureServices {
+		seen[key.project] = struct{}{}
+	}
+	projects := make([]string, 0, len(seen))
+	for project := range seen {
+		projects = append(projects, project)
+	}
+	sort.Strings(projects)
+	return projects
+}
+
+// Returns the objects of the snapshot keyed by kind, project and name.
+func (s *snapshot) index() map[ObjectKind]map[objectKey]proto.Message {
+	index := map[ObjectKind]map[objectKey]proto.Message{
+		KindEntity:              make(map[objectKey]proto.Message),
+		KindFeatureView:         make(map[objectKey]proto.Message),
+		KindOnDemandFeatureView: make(map[objectKey]proto.Message),
+		KindFeatureService:      make(map[objectKey]proto.Message),
+	}
+	for key, entity := range s.entities {
+		index[KindEntity][key] = entity
+	}
+	for key, featureView := range s.featureViews {
+		index[KindFeatureView][key] = featureView
+	}
+	for key, odfv := range s.onDemandFeatureViews {
+		index[KindOnDemandFeatureView][key] = odfv
+	}
+	for key, featureService := range s.featureServices {
+		index[KindFeatureService][key] = featureService
+	}
+	return index
+}
+
+// Returns the feature tables of the given Registry proto keyed by project and name.
+func featureTableIndex(registry *core.Registry) map[objectKey]proto.Message {
+	index := make(map[objectKey]proto.Message)
+	for _, featureTable := range registry.GetFeatureTables() {
+		index[objectKey{featureTable.GetSpec().GetProject(), featureTable.GetSpec().GetName()}] = featureTable
+	}
+	return index
+}
+
+// Returns the spec of a registry object proto.
+func specOf(object proto.Message) proto.Message {
+	switch object := object.(type) {
+	case *core.Entity:
+		return object.GetSpec()
+	case *core.FeatureView:
+		return object.GetSpec()
+	case *core.OnDemandFeatureView:
+		return object.GetSpec()
+	case *core.FeatureService:
+		return object.GetSpec()
+	case *core.FeatureTable:
+		return object.GetSpec()
+	default:
+		return object
+	}
+}
@@ -0,0 +1,115 @@
+package registry
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// ErrRegistryNotFound indicates that no registry is stored in the registry store.
+	ErrRegistryNotFound = errors.New("registry does not exist")
+
+	// ErrVersionConflict indicates that the stored registry was updated concurrently,
+	// its version id no longer matching the expected version id.
+	ErrVersionConflict = errors.New("registry was updated concurrently")
+)
+
+// RegistryStore reads and writes serialized Registry protos.
+type RegistryStore interface {
+	// Get returns the stored registry.
+	// Returns ErrRegistryNotFound if no registry is stored.
+	Get(ctx context.Context) (*core.Registry, error)
+
+	// Put replaces the stored registry with the given registry, provided the version id of the stored
+	// registry is expectedVersionID. An empty expectedVersionID expects no registry to be stored.
+	// The registry is written with a newly generated version id and last updated time, which is returned.
+	// Returns ErrVersionConflict if the stored registry's version id does not match.
+	Put(ctx context.Context, registry *core.Registry, expectedVersionID string) (string, error)
+}
+
+// NewRegistryStore creates a RegistryStore for the given registry location:
+// "s3://bucket/key" for S3 compatible object stores configured from the environment (see S3ConfigFromEnv),
+// "http://" or "https://" URLs for HTTP servers and "file://" URLs or plain paths for local files.
+func NewRegistryStore(location string) (RegistryStore, error) {
+	switch {
+	case strings.HasPrefix(location, "s3://"):
+		u, err := url.Parse(location)
+		if err != nil {
+			return nil, err
+		}
+		return NewS3Store(u.Host, strings.TrimPrefix(u.Path, "/"), S3ConfigFromEnv()), nil
+	case strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://"):
+		return NewHTTPStore(location, nil), nil
+	case strings.HasPrefix(location, "file://"):
+		return NewFileStore(strings.TrimPrefix(location, "file://")), nil
+	case strings.Contains(location, "://"):
+		return nil, fmt.Errorf("unsupported registry location: %s", location)
+	default:
+		return NewFileStore(location), nil
+	}
+}
+
+// Returns the value of the first set environment variable of the given names.
+func getenv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// Checks that the version id of the stored registry matches expectedVersionID.
+// A missing registry matches an empty expectedVersionID.
+func checkVersion(stored *core.Registry, expectedVersionID string) error {
+	if stored.GetVersionId() != expectedVersionID {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// Serializes a copy of the given registry stamped with a new version id and last updated time.
+func stampRegistry(registry *core.Registry) ([]byte, string, error) {
+	versionID, err := newVersionID()
+	if err != nil {
+		return nil, "", err
+	}
+	stamped := proto.Clone(registry).(*core.Registry)
+	stamped.VersionId = versionID
+	stamped.LastUpdated = timestamppb.New(time.Now())
+	registryBytes, err := proto.Marshal(stamped)
+	if err != nil {
+		return nil, "", err
+	}
+	return registryBytes, versionID, nil
+}
+
+// Generates a random version id in UUID v4 format, as generated by the Python SDK.
+func newVersionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// Decodes a serialized Registry proto.
+func decodeRegistry(registryBytes []byte) (*core.Registry, error) {
+	registry := &core.Registry{}
+	if err := proto.Unmarshal(registryBytes, registry); err != nil {
+		return nil, fmt.Errorf("failed to decode registry: %v", err)
+	}
+	return registry, nil
+}
@@ -0,0 +1,76 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+// FileStore is a RegistryStore backed by a local file.
+type FileStore struct {
+	path string
+}
+
+// NewFileStore creates a FileStore for the registry file at the given path.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Get reads the registry from the file.
+func (s *FileStore) Get(ctx context.Context) (*core.Registry, error) {
+	registryBytes, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, ErrRegistryNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read registry: %v", err)
+	}
+	return decodeRegistry(registryBytes)
+}
+
+// Put atomically replaces the registry file. Concurrent writers are excluded with a lock file
+// next to the registry file.
+func (s *FileStore) Put(ctx context.Context, registry *core.Registry, expectedVersionID string) (string, error) {
+	lockPath := s.path + ".lock"
+	lock, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if os.IsExist(err) {
+		return "", fmt.Errorf("registry is locked by another writer, remove %s if the lock is stale", lockPath)
+	} else if err != nil {
+		return "", fmt.Errorf("failed to lock registry: %v", err)
+	}
+	lock.Close()
+	defer os.Remove(lockPath)
+
+	stored, err := s.Get(ctx)
+	if err != nil && err != ErrRegistryNotFound {
+		return "", err
+	}
+	if err := checkVersion(stored, expectedVersionID); err != nil {
+		return "", err
+	}
+	registryBytes, versionID, err := stampRegistry(registry)
+	if err != nil {
+		return "", err
+	}
+
+	// Write to a temporary file first so readers never observe a partially written registry.
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to write registry: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(registryBytes); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write registry: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write registry: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return "", fmt.Errorf("failed to write registry: %v", err)
+	}
+	return versionID, nil
+}
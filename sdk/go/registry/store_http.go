@@ -0,0 +1,113 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+// HTTPStore is a RegistryStore backed by a registry file served over HTTP(S).
+// Updates are written with a PUT request conditioned on the ETag of the stored registry.
+type HTTPStore struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPStore creates a HTTPStore for the registry at the given URL.
+// client - HTTP client used to make requests. Uses http.DefaultClient if nil.
+func NewHTTPStore(url string, client *http.Client) *HTTPStore {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPStore{url: url, client: client}
+}
+
+// Get fetches the registry from the URL.
+func (s *HTTPStore) Get(ctx context.Context) (*core.Registry, error) {
+	registry, _, err := s.get(ctx)
+	return registry, err
+}
+
+// Fetches the registry and its ETag.
+func (s *HTTPStore) get(ctx context.Context) (*core.Registry, string, error) {
+	req, err := http.NewRequest(http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch registry: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, "", ErrRegistryNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("registry endpoint returned unexpected status: %s", resp.Status)
+	}
+	registryBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch registry: %v", err)
+	}
+	registry, err := decodeRegistry(registryBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	return registry, resp.Header.Get("ETag"), nil
+}
+
+// Put uploads the registry to the URL.
+func (s *HTTPStore) Put(ctx context.Context, registry *core.Registry, expectedVersionID string) (string, error) {
+	stored, etag, err := s.get(ctx)
+	if err != nil && err != ErrRegistryNotFound {
+		return "", err
+	}
+	if err := checkVersion(stored, expectedVersionID); err != nil {
+		return "", err
+	}
+	registryBytes, versionID, err := stampRegistry(registry)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, s.url, bytes.NewReader(registryBytes))
+	if err != nil {
+		return "", err
+	}
+	if req.Header, err = conditionalHeader(stored, etag); err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("failed to upload registry: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		return "", ErrVersionConflict
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("registry endpoint returned unexpected status: %s", resp.Status)
+	}
+	return versionID, nil
+}
+
+// Returns the headers conditioning a write on the stored registry being unchanged since it was read:
+// on its ETag if it exists, or on no registry existing otherwise. Returns an error if the stored registry has no
+// ETag, as the write could then overwrite concurrent updates.
+func conditionalHeader(stored *core.Registry, etag string) (http.Header, error) {
+	header := http.Header{}
+	switch {
+	case stored == nil:
+		header.Set("If-None-Match", "*")
+	case etag != "":
+		header.Set("If-Match", etag)
+	default:
+		return nil, fmt.Errorf("registry endpoint returned no ETag, updates can not be conditioned on the stored registry")
+	}
+	return header, nil
+}
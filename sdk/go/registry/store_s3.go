@@ -0,0 +1,241 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+)
+
+// S3Config configures the connection to an S3 compatible object store.
+type S3Config struct {
+	// Optional: URL of the object store, ie. "http://localhost:9000" for MinIO.
+	// Defaults to the AWS S3 endpoint of the region if unspecified.
+	Endpoint string
+	// Region of the bucket, used to sign requests. Defaults to us-east-1 if unspecified.
+	Region string
+	// Credentials used to sign requests. Requests are not signed if AccessKeyID is unspecified.
+	AccessKeyID     string
+	SecretAccessKey string
+	// Optional: Session token of temporary credentials.
+	SessionToken string
+	// Optional: HTTP client used to make requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// S3ConfigFromEnv returns the S3Config described by the standard AWS environment variables and
+// FEAST_S3_ENDPOINT_URL, which overrides the endpoint as in the Python SDK.
+func S3ConfigFromEnv() S3Config {
+	return S3Config{
+		Endpoint:        getenv("FEAST_S3_ENDPOINT_URL"),
+		Region:          getenv("AWS_REGION", "AWS_DEFAULT_REGION"),
+		AccessKeyID:     getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    getenv("AWS_SESSION_TOKEN"),
+	}
+}
+
+// S3Store is a RegistryStore backed by an object in an S3 compatible object store.
+// Requests are made with path style URLs and signed with AWS Signature Version 4.
+// Updates are conditioned on the ETag of the stored object.
+type S3Store struct {
+	bucket string
+	key    string
+	config S3Config
+}
+
+// NewS3Store creates a S3Store for the registry object with the given key in the given bucket.
+func NewS3Store(bucket string, key string, config S3Config) *S3Store {
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", config.Region)
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	return &S3Store{bucket: bucket, key: key, config: config}
+}
+
+// Get downloads the registry object.
+func (s *S3Store) Get(ctx context.Context) (*core.Registry, error) {
+	registry, _, err := s.get(ctx)
+	return registry, err
+}
+
+// Downloads the registry object and its ETag.
+func (s *S3Store) get(ctx context.Context) (*core.Registry, string, error) {
+	resp, err := s.do(ctx, http.MethodGet, nil, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch registry: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, "", ErrRegistryNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", s.responseError(resp)
+	}
+	registryBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch registry: %v", err)
+	}
+	registry, err := decodeRegistry(registryBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	return registry, resp.Header.Get("ETag"), nil
+}
+
+// Put uploads the registry object.
+func (s *S3Store) Put(ctx context.Context, registry *core.Registry, expectedVersionID string) (string, error) {
+	stored, etag, err := s.get(ctx)
+	if err != nil && err != ErrRegistryNotFound {
+		return "", err
+	}
+	if err := checkVersion(stored, expectedVersionID); err != nil {
+		return "", err
+	}
+	registryBytes, versionID, err := stampRegistry(registry)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := conditionalHeader(stored, etag)
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.do(ctx, http.MethodPut, header, registryBytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload registry: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusPreconditionFailed || resp.StatusCode == http.StatusConflict {
+		return "", ErrVersionConflict
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", s.responseError(resp)
+	}
+	return versionID, nil
+}
+
+// Makes a signed request for the registry object.
+func (s *S3Store) do(ctx context.Context, method string, header http.Header, body []byte) (*http.Response, error) {
+	objectURL := strings.TrimRight(s.config.Endpoint, "/") + "/" + s.bucket + "/" + escapePath(s.key)
+	req, err := http.NewRequestWithContext(ctx, method, objectURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if s.config.AccessKeyID != "" {
+		signV4(req, body, s.config, time.Now())
+	}
+	return s.config.HTTPClient.Do(req)
+}
+
+// Builds an error from an unexpected object store response.
+func (s *S3Store) responseError(resp *http.Response) error {
+	body, _ := ioutil.ReadAll(resp.Body)
+	return fmt.Errorf("object store returned unexpected status %s for s3://%s/%s: %s",
+		resp.Status, s.bucket, s.key, strings.TrimSpace(string(body)))
+}
+
+// Signs the request with AWS Signature Version 4, signing the host and all headers set on the request.
+// See https://docs.aws.amazon.com/general/latest/gr/sigv4_signing.html
+func signV4(req *http.Request, body []byte, config S3Config, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if config.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", config.SessionToken)
+	}
+
+	// Canonical headers are the lower cased header names, sorted, with trimmed values.
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req.URL),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + config.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+config.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, config.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// Returns the URI encoded path of the URL, encoding each segment as required by Signature Version 4.
+func canonicalURI(u *url.URL) string {
+	if path := escapePath(u.Path); path != "" {
+		return path
+	}
+	return "/"
+}
+
+// URI encodes each segment of a slash separated path, escaping all bytes but unreserved characters as
+// Signature Version 4 requires. Paths escaped this way are sent as is by HTTP clients.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		var escaped strings.Builder
+		for _, b := range []byte(segment) {
+			if ('A' <= b && b <= 'Z') || ('a' <= b && b <= 'z') || ('0' <= b && b <= '9') ||
+				b == '-' || b == '_' || b == '.' || b == '~' {
+				escaped.WriteByte(b)
+			} else {
+				fmt.Fprintf(&escaped, "%%%02X", b)
+			}
+		}
+		segments[i] = escaped.String()
+	}
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,147 @@
+package registry
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Interval at which watched registries with refreshing disabled are loaded again.
+const defaultWatchInterval = time.Minute
+
+// ChangeType is the type of change made to a registry object.
+type ChangeType string
+
+const (
+	// Added indicates that the object was added to the registry.
+	Added ChangeType = "added"
+	// Updated indicates that the spec of the object changed.
+	Updated ChangeType = "updated"
+	// Deleted indicates that the object was removed from the registry.
+	Deleted ChangeType = "deleted"
+)
+
+// ObjectKind is the kind of a registry object.
+type ObjectKind string
+
+const (
+	KindEntity              ObjectKind = "entity"
+	KindFeatureView         ObjectKind = "feature view"
+	KindOnDemandFeatureView ObjectKind = "on demand feature view"
+	KindFeatureService      ObjectKind = "feature service"
+	// KindFeatureTable is the kind of the feature tables of Feast Core, only reported by plans.
+	KindFeatureTable ObjectKind = "feature table"
+)
+
+// Kinds of registry objects, in the order changes to them are reported.
+var objectKinds = []ObjectKind{KindEntity, KindFeatureView, KindOnDemandFeatureView, KindFeatureService}
+
+// Event describes a change made to a registry object between two versions of the registry.
+type Event struct {
+	Type    ChangeType
+	Kind    ObjectKind
+	Project string
+	Name    string
+	// VersionID is the version id of the registry the change was observed in.
+	VersionID string
+	// Old is the object proto before the change, nil if the object was added.
+	Old proto.Message
+	// New is the object proto after the change, nil if the object was deleted.
+	New proto.Message
+}
+
+// Watch returns a channel of the changes made to the registry, which is closed once ctx is done.
+// The registry is loaded again at its refresh interval, or every minute if refreshing is disabled,
+// and whenever its version id changes, the objects of the old and new version are diffed.
+// Events of a version are sent ordered by kind, project and name. Only changes made after
+// the call to Watch are reported, and errors loading the registry are ignored until the next attempt.
+func (r *Registry) Watch(ctx context.Context) <-chan Event {
+	interval := r.refreshInterval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	events := make(chan Event)
+
+	r.mu.RLock()
+	last := r.snapshot
+	r.mu.RUnlock()
+
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			r.mu.RLock()
+			changed := r.changed
+			r.mu.RUnlock()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.Refresh(ctx)
+			case <-changed:
+			}
+
+			r.mu.RLock()
+			current := r.snapshot
+			r.mu.RUnlock()
+			if current.proto.GetVersionId() == last.proto.GetVersionId() {
+				continue
+			}
+			for _, event := range diffSnapshots(last, current) {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			last = current
+		}
+	}()
+	return events
+}
+
+// Diffs the objects of two registry snapshots, comparing objects by their specs.
+func diffSnapshots(previous *snapshot, current *snapshot) []Event {
+	versionID := current.proto.GetVersionId()
+	previousIndex, currentIndex := previous.index(), current.index()
+
+	var events []Event
+	for _, kind := range objectKinds {
+		events = append(events, diffObjects(kind, previousIndex[kind], currentIndex[kind], versionID)...)
+	}
+	return events
+}
+
+// Diffs two sets of objects of the given kind keyed by project and name, comparing objects by their specs.
+// Events are ordered by project and name.
+func diffObjects(kind ObjectKind, oldObjects map[objectKey]proto.Message, newObjects map[objectKey]proto.Message, versionID string) []Event {
+	var events []Event
+	for key, newObject := range newObjects {
+		event := Event{Kind: kind, Project: key.project, Name: key.name, VersionID: versionID, New: newObject}
+		if oldObject, ok := oldObjects[key]; !ok {
+			event.Type = Added
+		} else if !proto.Equal(specOf(oldObject), specOf(newObject)) {
+			event.Type = Updated
+			event.Old = oldObject
+		} else {
+			continue
+		}
+		events = append(events, event)
+	}
+	for key, oldObject := range oldObjects {
+		if _, ok := newObjects[key]; !ok {
+			events = append(events, Event{Type: Deleted, Kind: kind, Project: key.project, Name: key.name, VersionID: versionID, Old: oldObject})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Project != events[j].Project {
+			return events[i].Project < events[j].Project
+		}
+		return events[i].Name < events[j].Name
+	})
+	return events
+}
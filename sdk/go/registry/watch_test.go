@@ -0,0 +1,94 @@
+package registry
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Receives events from the channel until count events are received or the timeout elapses.
+func receiveEvents(t *testing.T, events <-chan Event, count int) []Event {
+	var received []Event
+	timeout := time.After(5 * time.Second)
+	for len(received) < count {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatalf("Event channel closed after %d events, expected %d", len(received), count)
+			}
+			received = append(received, event)
+		case <-timeout:
+			t.Fatalf("Timed out after %d events, expected %d", len(received), count)
+		}
+	}
+	return received
+}
+
+func TestRegistryWatch(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "registry.db")
+	writeRegistry(t, path, testRegistryProto("v1"))
+
+	registry, err := NewRegistry(path, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := registry.Watch(ctx)
+
+	// Remove driver_pii, update driver_stats and add a new entity.
+	updated := testRegistryProto("v2")
+	updated.FeatureViews = updated.FeatureViews[:1]
+	updated.FeatureViews[0].Spec.Features = updated.FeatureViews[0].Spec.Features[:1]
+	updated.Entities = append(updated.Entities, &core.Entity{Spec: &core.EntitySpecV2{Name: "vehicle", Project: "driver_project"}})
+	// Meta only changes should not be reported.
+	updated.FeatureServices[0].Meta = &core.FeatureServiceMeta{}
+	writeRegistry(t, path, updated)
+
+	type change struct {
+		Type      ChangeType
+		Kind      ObjectKind
+		Project   string
+		Name      string
+		VersionID string
+		HasOld    bool
+		HasNew    bool
+	}
+	var got []change
+	for _, event := range receiveEvents(t, events, 4) {
+		got = append(got, change{event.Type, event.Kind, event.Project, event.Name, event.VersionID, event.Old != nil, event.New != nil})
+	}
+	want := []change{
+		{Added, KindEntity, "driver_project", "vehicle", "v2", false, true},
+		{Deleted, KindFeatureView, "customer_project", "customer_stats", "v2", true, false},
+		{Deleted, KindFeatureView, "driver_project", "driver_pii", "v2", true, false},
+		{Updated, KindFeatureView, "driver_project", "driver_stats", "v2", true, true},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected events (-want +got):\n%s", diff)
+	}
+
+	// Refreshing the same version should not produce events.
+	writeRegistry(t, path, updated)
+	select {
+	case event := <-events:
+		t.Errorf("Unexpected event for unchanged registry: %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("Expected event channel to be closed after cancellation")
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Timed out waiting for event channel to close")
+	}
+}
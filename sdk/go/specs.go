@@ -0,0 +1,506 @@
+package feast
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+// Name of the file listing the paths LoadSpecs ignores, relative to the loaded directory.
+const feastIgnoreFile = ".feastignore"
+
+// Kinds of specs, given by the kind field of YAML spec documents.
+const (
+	specKindEntity       = "Entity"
+	specKindFeatureTable = "FeatureTable"
+	specKindFeatureView  = "FeatureView"
+)
+
+// SpecError is a problem found in a YAML spec, located by file and line.
+type SpecError struct {
+	File    string
+	Line    int
+	Message string
+}
+
+func (e *SpecError) Error() string {
+	return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Message)
+}
+
+// SpecErrors lists all problems found loading YAML specs.
+type SpecErrors []*SpecError
+
+func (e SpecErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return "invalid specs:\n" + strings.Join(messages, "\n")
+}
+
+// Specs are the entities, feature tables and feature views defined by YAML spec files.
+type Specs struct {
+	Entities      []*Entity
+	FeatureTables []*core.FeatureTableSpec
+	FeatureViews  []*FeatureView
+}
+
+// LoadSpecs reads the YAML specs of the .yaml and .yml files found recursively in the given directory, skipping
+// paths matching the patterns listed in its .feastignore file. Each YAML document defines a single object:
+//
+//	kind: FeatureView
+//	spec:
+//	  name: driver_stats
+//	  entities: [driver]
+//	  features:
+//	    - name: rating
+//	      value_type: DOUBLE
+//	  ttl: 24h
+//
+// Specs are validated against the EntitySpecV2, FeatureTableSpec and FeatureViewSpec protos, with fields named as in
+// the protos or their JSON names, enums given by name and durations and timestamps as strings. Projects are set
+// when the specs are applied. Returns SpecErrors listing all problems found.
+func LoadSpecs(dir string) (*Specs, error) {
+	ignored, err := readFeastIgnore(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	err = filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		if rel != "." && matchesAnyPattern(ignored, filepath.ToSlash(rel)) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ext := filepath.Ext(file); !info.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			files = append(files, file)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	loader := &specLoader{specs: &Specs{}, defined: make(map[string]string)}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		loader.load(file, data)
+	}
+	if len(loader.errs) > 0 {
+		return nil, loader.errs
+	}
+	return loader.specs, nil
+}
+
+// ParseSpecs parses the YAML specs of a single file, named file in errors. See LoadSpecs.
+func ParseSpecs(file string, data []byte) (*Specs, error) {
+	loader := &specLoader{specs: &Specs{}, defined: make(map[string]string)}
+	loader.load(file, data)
+	if len(loader.errs) > 0 {
+		return nil, loader.errs
+	}
+	return loader.specs, nil
+}
+
+// Repo returns a feature repo of the given project holding the entities and feature views of the specs.
+func (s *Specs) Repo(project string) *FeatureRepo {
+	return &FeatureRepo{Project: project, Entities: s.Entities, FeatureViews: s.FeatureViews}
+}
+
+// MergeRegistry returns a copy of the given Registry proto with the specs added to the given project, as
+// FeatureRepo.MergeRegistry does. Feature tables replace those of the same name in the project unless they are
+// unchanged, as determined by CoreClient.Apply. The hash of feature tables is left empty, as only Feast Core computes
+// it.
+func (s *Specs) MergeRegistry(project string, registry *core.Registry) (*core.Registry, error) {
+	merged, err := s.Repo(project).MergeRegistry(registry)
+	if err != nil {
+		return nil, err
+	}
+	now := merged.GetLastUpdated()
+	for _, spec := range s.FeatureTables {
+		featureTable := &core.FeatureTable{
+			Spec: proto.Clone(spec).(*core.FeatureTableSpec),
+			Meta: &core.FeatureTableMeta{CreatedTimestamp: now, LastUpdatedTimestamp: now},
+		}
+		featureTable.Spec.Project = project
+		replaced := false
+		for i, existing := range merged.FeatureTables {
+			if existing.GetSpec().GetProject() != project || existing.GetSpec().GetName() != spec.GetName() {
+				continue
+			}
+			replaced = true
+			// Unchanged feature tables keep their revision, as in Feast Core.
+			if featureTableSpecEqual(existing.GetSpec(), featureTable.Spec) {
+				continue
+			}
+			featureTable.Meta.CreatedTimestamp = createdTimestamp(existing.GetMeta().GetCreatedTimestamp(), now)
+			featureTable.Meta.Revision = existing.GetMeta().GetRevision() + 1
+			merged.FeatureTables[i] = featureTable
+		}
+		if !replaced {
+			merged.FeatureTables = append(merged.FeatureTables, featureTable)
+		}
+	}
+	return merged, nil
+}
+
+// ApplySpecs applies the entities and feature tables of the specs to the given project, see Apply.
+// Feast Core does not manage feature views, specs defining feature views are rejected.
+func (c *CoreClient) ApplySpecs(ctx context.Context, project string, specs *Specs) (*ApplyResult, error) {
+	if len(specs.FeatureViews) > 0 {
+		return nil, errors.New("feature views can not be applied to Feast Core, apply them to a registry instead")
+	}
+	return c.Apply(ctx, project, specs.Entities, specs.FeatureTables)
+}
+
+// Reads the patterns of the .feastignore file of the directory, if any. Everything following a # is a comment.
+func readFeastIgnore(dir string) ([]string, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, feastIgnoreFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var patterns []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if line = strings.Trim(strings.TrimSpace(line), "/"); line != "" {
+			patterns = append(patterns, line)
+		}
+	}
+	return patterns, nil
+}
+
+// Reports whether the slash separated relative path matches any of the glob patterns, where ** matches any number
+// of directories. Matching a directory matches everything below it.
+func matchesAnyPattern(patterns []string, relPath string) bool {
+	for _, pattern := range patterns {
+		if matchSegments(strings.Split(pattern, "/"), strings.Split(relPath, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSegments(pattern []string, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	matched, err := path.Match(pattern[0], segments[0])
+	return err == nil && matched && matchSegments(pattern[1:], segments[1:])
+}
+
+// specLoader accumulates the specs and problems of the loaded files.
+type specLoader struct {
+	specs *Specs
+	errs  SpecErrors
+	// Locations of the defined objects, keyed by kind and name.
+	defined map[string]string
+	file    string
+}
+
+func (l *specLoader) addError(node *yaml.Node, format string, args ...interface{}) {
+	l.errs = append(l.errs, &SpecError{File: l.file, Line: node.Line, Message: fmt.Sprintf(format, args...)})
+}
+
+// Loads the YAML documents of a file.
+func (l *specLoader) load(file string, data []byte) {
+	l.file = file
+	decoder := yaml.NewDecoder(strings.NewReader(string(data)))
+	for {
+		document := &yaml.Node{}
+		err := decoder.Decode(document)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			line, message := yamlError(err)
+			l.errs = append(l.errs, &SpecError{File: file, Line: line, Message: message})
+			return
+		}
+		if len(document.Content) == 0 || document.Content[0].Tag == "!!null" {
+			continue
+		}
+		l.loadDocument(document.Content[0])
+	}
+}
+
+// Returns the line and message of a YAML syntax error, with a line of 0 if unknown.
+func yamlError(err error) (int, string) {
+	var line int
+	if _, scanErr := fmt.Sscanf(err.Error(), "yaml: line %d:", &line); scanErr != nil {
+		return 0, err.Error()
+	}
+	return line, strings.TrimPrefix(err.Error(), fmt.Sprintf("yaml: line %d: ", line))
+}
+
+// Loads the object defined by a YAML document.
+func (l *specLoader) loadDocument(node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		l.addError(node, "spec document must be a mapping with kind and spec fields")
+		return
+	}
+	var kind, spec *yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		switch key.Value {
+		case "kind":
+			kind = value
+		case "spec":
+			spec = value
+		default:
+			l.addError(key, "unknown field %s, spec documents only have kind and spec fields", key.Value)
+		}
+	}
+	if kind == nil || spec == nil {
+		l.addError(node, "spec document must have kind and spec fields")
+		return
+	}
+
+	var message proto.Message
+	switch kind.Value {
+	case specKindEntity:
+		message = &core.EntitySpecV2{}
+	case specKindFeatureTable:
+		message = &core.FeatureTableSpec{}
+	case specKindFeatureView:
+		message = &core.FeatureViewSpec{}
+	default:
+		l.addError(kind, "unknown kind %s, expected one of %s, %s or %s", kind.Value, specKindEntity, specKindFeatureTable, specKindFeatureView)
+		return
+	}
+	errCount := len(l.errs)
+	l.decodeMessage(spec, proto.MessageReflect(message))
+	if len(l.errs) > errCount {
+		return
+	}
+
+	name := proto.MessageReflect(message).Get(proto.MessageReflect(message).Descriptor().Fields().ByName("name")).String()
+	if name == "" {
+		l.addError(spec, "%s has no name", kind.Value)
+		return
+	}
+	location := fmt.Sprintf("%s:%d", l.file, spec.Line)
+	key := kind.Value + " " + name
+	if defined, ok := l.defined[key]; ok {
+		l.addError(spec, "%s %s is already defined at %s", kind.Value, name, defined)
+		return
+	}
+	l.defined[key] = location
+
+	switch spec := message.(type) {
+	case *core.EntitySpecV2:
+		l.specs.Entities = append(l.specs.Entities, NewEntityFromProto(&core.Entity{Spec: spec}))
+	case *core.FeatureTableSpec:
+		l.specs.FeatureTables = append(l.specs.FeatureTables, spec)
+	case *core.FeatureViewSpec:
+		l.specs.FeatureViews = append(l.specs.FeatureViews, NewFeatureViewFromProto(&core.FeatureView{Spec: spec}))
+	}
+}
+
+// Decodes a YAML mapping into the message, reporting unknown fields and values not matching the field types.
+func (l *specLoader) decodeMessage(node *yaml.Node, message protoreflect.Message) {
+	if node.Kind != yaml.MappingNode {
+		l.addError(node, "expected a mapping for %s", message.Descriptor().Name())
+		return
+	}
+	fields := message.Descriptor().Fields()
+	// Fields seen in the mapping, including those set to their zero value, and keys setting members of oneofs.
+	seen := make(map[protoreflect.FieldNumber]bool)
+	oneofs := make(map[protoreflect.FullName]*yaml.Node)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		field := fields.ByName(protoreflect.Name(key.Value))
+		if field == nil {
+			field = fields.ByJSONName(key.Value)
+		}
+		if field == nil {
+			l.addError(key, "unknown field %s of %s", key.Value, message.Descriptor().Name())
+			continue
+		}
+		if seen[field.Number()] {
+			l.addError(key, "field %s is set more than once", key.Value)
+			continue
+		}
+		seen[field.Number()] = true
+		if value.Tag == "!!null" {
+			continue
+		}
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			if other, ok := oneofs[oneof.FullName()]; ok {
+				l.addError(key, "field %s can not be set along with %s", key.Value, other.Value)
+				continue
+			}
+			oneofs[oneof.FullName()] = key
+		}
+
+		switch {
+		case field.IsList():
+			if value.Kind != yaml.SequenceNode {
+				l.addError(value, "expected a list for %s", key.Value)
+				continue
+			}
+			list := message.Mutable(field).List()
+			for _, element := range value.Content {
+				if v, ok := l.decodeValue(element, field, list.NewElement); ok {
+					list.Append(v)
+				}
+			}
+		case field.IsMap():
+			if value.Kind != yaml.MappingNode {
+				l.addError(value, "expected a mapping for %s", key.Value)
+				continue
+			}
+			entries := message.Mutable(field).Map()
+			for j := 0; j+1 < len(value.Content); j += 2 {
+				mapKey, ok := l.decodeScalar(value.Content[j], field.MapKey())
+				if !ok {
+					continue
+				}
+				if v, ok := l.decodeValue(value.Content[j+1], field.MapValue(), entries.NewValue); ok {
+					entries.Set(mapKey.MapKey(), v)
+				}
+			}
+		default:
+			if v, ok := l.decodeValue(value, field, func() protoreflect.Value { return message.NewField(field) }); ok {
+				message.Set(field, v)
+			}
+		}
+	}
+}
+
+// Decodes a single value of the field, using newMessage to create values of message fields.
+func (l *specLoader) decodeValue(node *yaml.Node, field protoreflect.FieldDescriptor, newMessage func() protoreflect.Value) (protoreflect.Value, bool) {
+	if field.Kind() != protoreflect.MessageKind && field.Kind() != protoreflect.GroupKind {
+		return l.decodeScalar(node, field)
+	}
+
+	switch field.Message().FullName() {
+	case "google.protobuf.Duration":
+		if node.Kind == yaml.ScalarNode {
+			if duration, err := time.ParseDuration(node.Value); err == nil {
+				return protoreflect.ValueOfMessage(proto.MessageReflect(durationpb.New(duration))), true
+			}
+		}
+		l.addError(node, "invalid duration %s for %s, expected ie. 3600s or 24h", node.Value, field.Name())
+		return protoreflect.Value{}, false
+	case "google.protobuf.Timestamp":
+		if node.Kind == yaml.ScalarNode {
+			if timestamp, err := time.Parse(time.RFC3339Nano, node.Value); err == nil {
+				return protoreflect.ValueOfMessage(proto.MessageReflect(timestamppb.New(timestamp))), true
+			}
+		}
+		l.addError(node, "invalid timestamp %s for %s, expected an RFC 3339 timestamp", node.Value, field.Name())
+		return protoreflect.Value{}, false
+	}
+	value := newMessage()
+	l.decodeMessage(node, value.Message())
+	return value, true
+}
+
+// Decodes a scalar value of the field.
+func (l *specLoader) decodeScalar(node *yaml.Node, field protoreflect.FieldDescriptor) (protoreflect.Value, bool) {
+	if node.Kind != yaml.ScalarNode {
+		l.addError(node, "expected a %s value for %s", field.Kind(), field.Name())
+		return protoreflect.Value{}, false
+	}
+	var err error
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(node.Value), true
+	case protoreflect.BoolKind:
+		var v bool
+		if v, err = strconv.ParseBool(node.Value); err == nil {
+			return protoreflect.ValueOfBool(v), true
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		var v int64
+		if v, err = strconv.ParseInt(node.Value, 10, 32); err == nil {
+			return protoreflect.ValueOfInt32(int32(v)), true
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		var v int64
+		if v, err = strconv.ParseInt(node.Value, 10, 64); err == nil {
+			return protoreflect.ValueOfInt64(v), true
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		var v uint64
+		if v, err = strconv.ParseUint(node.Value, 10, 32); err == nil {
+			return protoreflect.ValueOfUint32(uint32(v)), true
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		var v uint64
+		if v, err = strconv.ParseUint(node.Value, 10, 64); err == nil {
+			return protoreflect.ValueOfUint64(v), true
+		}
+	case protoreflect.FloatKind:
+		var v float64
+		if v, err = strconv.ParseFloat(node.Value, 32); err == nil {
+			return protoreflect.ValueOfFloat32(float32(v)), true
+		}
+	case protoreflect.DoubleKind:
+		var v float64
+		if v, err = strconv.ParseFloat(node.Value, 64); err == nil {
+			return protoreflect.ValueOfFloat64(v), true
+		}
+	case protoreflect.BytesKind:
+		var v []byte
+		if v, err = base64.StdEncoding.DecodeString(node.Value); err == nil {
+			return protoreflect.ValueOfBytes(v), true
+		}
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		if value := values.ByName(protoreflect.Name(node.Value)); value != nil {
+			return protoreflect.ValueOfEnum(value.Number()), true
+		}
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		l.addError(node, "invalid %s %s, expected one of %s", field.Name(), node.Value, strings.Join(names, ", "))
+		return protoreflect.Value{}, false
+	}
+	l.addError(node, "invalid %s value %s for %s", field.Kind(), node.Value, field.Name())
+	return protoreflect.Value{}, false
+}
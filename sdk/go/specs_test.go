@@ -0,0 +1,230 @@
+package feast
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/core"
+	"github.com/feast-dev/feast/sdk/go/protos/feast/types"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const testSpecs = `kind: Entity
+spec:
+  name: driver
+  valueType: INT64
+  join_key: driver_id
+---
+kind: FeatureTable
+spec:
+  name: driver_stats
+  entities: [driver]
+  features:
+    - name: trips
+      value_type: INT64
+  max_age: 3600s
+  labels:
+    team: drivers
+---
+kind: FeatureView
+spec:
+  name: driver_activity
+  entities: [driver]
+  features:
+    - name: rating
+      value_type: DOUBLE
+  ttl: 24h
+  online: true
+  batch_source:
+    type: BATCH_FILE
+    event_timestamp_column: event_timestamp
+    data_source_class_type: feast.infra.offline_stores.file_source.FileSource
+    file_options:
+      file_format: {parquet_format: {}}
+      file_url: s3://bucket/driver_activity.parquet
+`
+
+func TestParseSpecs(t *testing.T) {
+	specs, err := ParseSpecs("driver.yaml", []byte(testSpecs))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantEntity := &core.EntitySpecV2{Name: "driver", ValueType: types.ValueType_INT64, JoinKey: "driver_id"}
+	if diff := cmp.Diff(wantEntity, specs.Entities[0].Proto().GetSpec(), protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected entity (-want +got):\n%s", diff)
+	}
+	wantFeatureTable := &core.FeatureTableSpec{
+		Name:     "driver_stats",
+		Entities: []string{"driver"},
+		Features: []*core.FeatureSpecV2{{Name: "trips", ValueType: types.ValueType_INT64}},
+		MaxAge:   durationpb.New(time.Hour),
+		Labels:   map[string]string{"team": "drivers"},
+	}
+	if diff := cmp.Diff([]*core.FeatureTableSpec{wantFeatureTable}, specs.FeatureTables, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected feature tables (-want +got):\n%s", diff)
+	}
+	wantFeatureView := NewFeatureView("driver_activity", []string{"driver"},
+		FileSource{Path: "s3://bucket/driver_activity.parquet", EventTimestampColumn: "event_timestamp"},
+		Feature{Name: "rating", ValueType: types.ValueType_DOUBLE},
+	).WithTTL(24 * time.Hour).WithOnline(true)
+	if diff := cmp.Diff(wantFeatureView.Proto(), specs.FeatureViews[0].Proto(), protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected feature view (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseSpecsErrors(t *testing.T) {
+	tt := []struct {
+		name string
+		spec string
+		want string
+	}{
+		{
+			name: "Syntax error",
+			spec: "kind: Entity\nspec:\n  name: [driver\n",
+			want: "invalid specs:\nspecs.yaml:2: did not find expected ',' or ']'",
+		},
+		{
+			name: "Unknown kind",
+			spec: "kind: FeatureSet\nspec:\n  name: driver\n",
+			want: "invalid specs:\nspecs.yaml:1: unknown kind FeatureSet, expected one of Entity, FeatureTable or FeatureView",
+		},
+		{
+			name: "Unknown field and invalid enum",
+			spec: "kind: Entity\nspec:\n  name: driver\n  value_typ: INT64\n  join_key: driver_id\n---\nkind: FeatureView\nspec:\n  name: driver_stats\n  features:\n    - name: rating\n      value_type: DECIMAL\n",
+			want: "invalid specs:\nspecs.yaml:4: unknown field value_typ of EntitySpecV2\n" +
+				"specs.yaml:12: invalid value_type DECIMAL, expected one of INVALID, BYTES, STRING, INT32, INT64, DOUBLE, FLOAT, BOOL, UNIX_TIMESTAMP, BYTES_LIST, STRING_LIST, INT32_LIST, INT64_LIST, DOUBLE_LIST, FLOAT_LIST, BOOL_LIST, UNIX_TIMESTAMP_LIST, NULL",
+		},
+		{
+			name: "Invalid values",
+			spec: "kind: FeatureView\nspec:\n  name: driver_stats\n  entities: driver\n  ttl: one day\n  online: maybe\n",
+			want: "invalid specs:\nspecs.yaml:4: expected a list for entities\n" +
+				"specs.yaml:5: invalid duration one day for ttl, expected ie. 3600s or 24h\n" +
+				"specs.yaml:6: invalid bool value maybe for online",
+		},
+		{
+			name: "Duplicate fields",
+			spec: "kind: FeatureView\nspec:\n  name: driver_stats\n  online: false\n  online: true\n  ttl: 3600s\n  ttl: null\n",
+			want: "invalid specs:\nspecs.yaml:5: field online is set more than once\nspecs.yaml:7: field ttl is set more than once",
+		},
+		{
+			name: "Conflicting oneof fields",
+			spec: "kind: FeatureView\nspec:\n  name: driver_stats\n  batch_source:\n    type: BATCH_FILE\n    file_options:\n      file_url: driver_stats.parquet\n    bigquery_options:\n      table_ref: project:dataset.driver_stats\n",
+			want: "invalid specs:\nspecs.yaml:8: field bigquery_options can not be set along with file_options",
+		},
+		{
+			name: "Missing and duplicate names",
+			spec: "kind: Entity\nspec:\n  value_type: INT64\n---\nkind: Entity\nspec:\n  name: driver\n---\nkind: Entity\nspec:\n  name: driver\n",
+			want: "invalid specs:\nspecs.yaml:3: Entity has no name\nspecs.yaml:11: Entity driver is already defined at specs.yaml:7",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseSpecs("specs.yaml", []byte(tc.spec))
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if err.Error() != tc.want {
+				t.Errorf("Expected error:\n%s\ngot:\n%s", tc.want, err.Error())
+			}
+		})
+	}
+}
+
+func TestLoadSpecsFeastIgnore(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		".feastignore":             "# Work in progress\ndrafts/\n**/*_test.yaml\n",
+		"entities.yaml":            "kind: Entity\nspec:\n  name: driver\n  value_type: INT64\n",
+		"views/driver.yml":         "kind: FeatureView\nspec:\n  name: driver_stats\n  entities: [driver]\n",
+		"views/driver_test.yaml":   "kind: Entity\nspec:\n  name: invalid\n  value_typ: INT64\n",
+		"drafts/vehicle.yaml":      "kind: Entity\nspec:\n  name: invalid\n  value_typ: INT64\n",
+		"views/README.md":          "Feature views of drivers.",
+		"views/nested/rider.yaml":  "kind: Entity\nspec:\n  name: rider\n  value_type: STRING\n",
+		"views/nested/drafts.yaml": "kind: Entity\nspec:\n  name: drafts\n  value_type: STRING\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	specs, err := LoadSpecs(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entity := range specs.Entities {
+		names = append(names, entity.Name())
+	}
+	for _, featureView := range specs.FeatureViews {
+		names = append(names, featureView.Name())
+	}
+	if diff := cmp.Diff([]string{"driver", "drafts", "rider", "driver_stats"}, names); diff != "" {
+		t.Errorf("Unexpected specs (-want +got):\n%s", diff)
+	}
+}
+
+func TestSpecsMergeRegistry(t *testing.T) {
+	specs, err := ParseSpecs("driver.yaml", []byte(testSpecs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry, err := specs.MergeRegistry("driver_project", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(registry.GetFeatureTables()) != 1 || registry.GetFeatureTables()[0].GetSpec().GetProject() != "driver_project" {
+		t.Fatalf("Expected feature table of driver_project, got %v", registry.GetFeatureTables())
+	}
+	if hash := registry.GetFeatureTables()[0].GetMeta().GetHash(); hash != "" {
+		t.Errorf("Expected no feature table hash, as only Feast Core computes it, got %s", hash)
+	}
+
+	// Unchanged feature tables are kept, changed ones get a new revision.
+	merged, err := specs.MergeRegistry("driver_project", registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(registry.GetFeatureTables(), merged.GetFeatureTables(), protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected feature table change (-want +got):\n%s", diff)
+	}
+	specs.FeatureTables[0].Labels["team"] = "riders"
+	merged, err = specs.MergeRegistry("driver_project", registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision := merged.GetFeatureTables()[0].GetMeta().GetRevision(); revision != 1 {
+		t.Errorf("Expected revision 1, got %d", revision)
+	}
+}
+
+func TestCoreClientApplySpecs(t *testing.T) {
+	specs, err := ParseSpecs("driver.yaml", []byte(testSpecs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &CoreClient{cli: newFakeCoreService()}
+	if _, err := client.ApplySpecs(context.Background(), "driver_project", specs); err == nil {
+		t.Error("Expected feature views to be rejected")
+	}
+
+	specs.FeatureViews = nil
+	result, err := client.ApplySpecs(context.Background(), "driver_project", specs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&ApplyResult{Applied: []string{"entity driver", "feature table driver_stats"}}, result); diff != "" {
+		t.Errorf("Unexpected apply result (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,236 @@
+package feast
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// Builds the client TLS config described by the security config, for connections to the given host.
+// Certificates given as PEM bytes take precedence over certificates given as file paths.
+// Returns an error if the configured certificates could not be loaded.
+func (security SecurityConfig) buildTLSConfig(host string) (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName: security.TLSServerName,
+		MinVersion: security.TLSMinVersion,
+	}
+
+	// Configure the CA certificates used to verify Service identity.
+	switch {
+	case len(security.TLSCert) > 0:
+		certPool, err := buildCertPool(security.TLSCert)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = certPool
+	case security.TLSCertPath != "":
+		reloader, err := newCertPoolReloader(security.TLSCertPath, security.TLSReloadInterval)
+		if err != nil {
+			return nil, err
+		}
+		if security.TLSReloadInterval > 0 {
+			// Verification is done against the reloaded pool in VerifyConnection instead,
+			// as RootCAs is copied by grpc when the transport credential is created.
+			// The server name is not taken from the connection state, which holds no name for IP hosts.
+			serverName := security.TLSServerName
+			if serverName == "" {
+				serverName = host
+			}
+			config.InsecureSkipVerify = true
+			config.VerifyConnection = reloader.verifier(serverName)
+		} else {
+			config.RootCAs = reloader.pool
+		}
+	default:
+		// Use system TLS certificate pool.
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = certPool
+	}
+
+	// Configure the client certificate used for mutual TLS.
+	switch {
+	case len(security.TLSClientCert) > 0 || len(security.TLSClientKey) > 0:
+		cert, err := tls.X509KeyPair(security.TLSClientCert, security.TLSClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS client certificate: %v", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	case security.TLSClientCertPath != "" || security.TLSClientKeyPath != "":
+		reloader, err := newKeyPairReloader(security.TLSClientCertPath, security.TLSClientKeyPath, security.TLSReloadInterval)
+		if err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = reloader.getClientCertificate
+	}
+	return config, nil
+}
+
+// Parses the given PEM encoded certificates into a certificate pool.
+func buildCertPool(pemCerts []byte) (*x509.CertPool, error) {
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(pemCerts) {
+		return nil, fmt.Errorf("failed to append TLS CA certificates")
+	}
+	return certPool, nil
+}
+
+// fileWatcher tracks the modification times of a set of files, reporting whether
+// they changed at most once every interval.
+type fileWatcher struct {
+	paths     []string
+	interval  time.Duration
+	modTimes  []time.Time
+	lastCheck time.Time
+}
+
+// Creates a new fileWatcher recording the current modification times of the given files.
+func newFileWatcher(interval time.Duration, paths ...string) (*fileWatcher, error) {
+	watcher := &fileWatcher{paths: paths, interval: interval}
+	modTimes, err := watcher.stat()
+	if err != nil {
+		return nil, err
+	}
+	watcher.modTimes = modTimes
+	watcher.lastCheck = time.Now()
+	return watcher, nil
+}
+
+func (watcher *fileWatcher) stat() ([]time.Time, error) {
+	modTimes := make([]time.Time, len(watcher.paths))
+	for i, path := range watcher.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+// Reports whether any of the watched files changed since the last call that returned true.
+// Files are not checked if reloading is disabled or the check interval has not yet elapsed.
+func (watcher *fileWatcher) changed() bool {
+	if watcher.interval <= 0 || time.Since(watcher.lastCheck) < watcher.interval {
+		return false
+	}
+	watcher.lastCheck = time.Now()
+	modTimes, err := watcher.stat()
+	if err != nil {
+		// Files might be mid rotation, keep using the last loaded version.
+		return false
+	}
+	for i := range modTimes {
+		if !modTimes[i].Equal(watcher.modTimes[i]) {
+			watcher.modTimes = modTimes
+			return true
+		}
+	}
+	return false
+}
+
+// certPoolReloader holds a CA certificate pool loaded from a file, reloading it when the file changes.
+type certPoolReloader struct {
+	mu      sync.Mutex
+	path    string
+	watcher *fileWatcher
+	pool    *x509.CertPool
+}
+
+func newCertPoolReloader(path string, interval time.Duration) (*certPoolReloader, error) {
+	watcher, err := newFileWatcher(interval, path)
+	if err != nil {
+		return nil, err
+	}
+	reloader := &certPoolReloader{path: path, watcher: watcher}
+	if reloader.pool, err = reloader.load(); err != nil {
+		return nil, err
+	}
+	return reloader, nil
+}
+
+func (reloader *certPoolReloader) load() (*x509.CertPool, error) {
+	pemCerts, err := ioutil.ReadFile(reloader.path)
+	if err != nil {
+		return nil, err
+	}
+	return buildCertPool(pemCerts)
+}
+
+// Returns the current certificate pool, reloading it first if the file has changed.
+func (reloader *certPoolReloader) certPool() *x509.CertPool {
+	reloader.mu.Lock()
+	defer reloader.mu.Unlock()
+	if reloader.watcher.changed() {
+		if pool, err := reloader.load(); err == nil {
+			reloader.pool = pool
+		}
+	}
+	return reloader.pool
+}
+
+// Returns a function verifying the server certificate chain against the current certificate pool, and its hostname
+// against the given server name, a DNS name or IP address. Connections are rejected if no server name is known.
+func (reloader *certPoolReloader) verifier(serverName string) func(tls.ConnectionState) error {
+	return func(state tls.ConnectionState) error {
+		if serverName == "" {
+			return fmt.Errorf("no server name to verify the TLS certificate against")
+		}
+		if len(state.PeerCertificates) == 0 {
+			return fmt.Errorf("server did not provide a TLS certificate")
+		}
+		intermediates := x509.NewCertPool()
+		for _, cert := range state.PeerCertificates[1:] {
+			intermediates.AddCert(cert)
+		}
+		_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+			DNSName:       serverName,
+			Roots:         reloader.certPool(),
+			Intermediates: intermediates,
+		})
+		return err
+	}
+}
+
+// keyPairReloader holds a client certificate loaded from files, reloading it when the files change.
+type keyPairReloader struct {
+	mu       sync.Mutex
+	certPath string
+	keyPath  string
+	watcher  *fileWatcher
+	cert     *tls.Certificate
+}
+
+func newKeyPairReloader(certPath string, keyPath string, interval time.Duration) (*keyPairReloader, error) {
+	if certPath == "" || keyPath == "" {
+		return nil, fmt.Errorf("both TLS client certificate and key paths must be provided")
+	}
+	watcher, err := newFileWatcher(interval, certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS client certificate: %v", err)
+	}
+	return &keyPairReloader{certPath: certPath, keyPath: keyPath, watcher: watcher, cert: &cert}, nil
+}
+
+// Returns the current client certificate, reloading it first if the files have changed.
+func (reloader *keyPairReloader) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	reloader.mu.Lock()
+	defer reloader.mu.Unlock()
+	if reloader.watcher.changed() {
+		// Certificate and key might be rotated separately, keep the last valid pair on mismatch.
+		if cert, err := tls.LoadX509KeyPair(reloader.certPath, reloader.keyPath); err == nil {
+			reloader.cert = &cert
+		}
+	}
+	return reloader.cert, nil
+}
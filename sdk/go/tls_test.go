@@ -0,0 +1,310 @@
+package feast
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/feast-dev/feast/sdk/go/protos/feast/serving"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// testCert is a PEM encoded certificate & key pair used in tests.
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+	tlsCert tls.Certificate
+}
+
+// Creates a test certificate signed by the given parent, or self signed if parent is nil.
+func newTestCert(t *testing.T, commonName string, parent *testCert, isCA bool) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              []string{commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	tlsCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key, certPEM: certPEM, keyPEM: keyPEM, tlsCert: tlsCert}
+}
+
+// Writes the given contents to a file in dir, returning its path.
+func writeTestFile(t *testing.T, dir string, name string, contents []byte) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, contents, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// Touches the file at path with a modification time in the future so it's seen as changed.
+func touchTestFile(t *testing.T, path string) {
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, future, future); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Starts a serving server that requires mutual TLS with client certificates signed by ca.
+func startMutualTLSServer(t *testing.T, ca *testCert, serverCert *testCert) (int, func()) {
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert.tlsCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	})))
+	serving.RegisterServingServiceServer(srv, &serving.UnimplementedServingServiceServer{})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(lis)
+	return lis.Addr().(*net.TCPAddr).Port, srv.Stop
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := newTestCert(t, "ca", nil, true)
+	serverCert := newTestCert(t, "feast.example", ca, false)
+	clientCert := newTestCert(t, "client", ca, false)
+	untrustedCert := newTestCert(t, "client", nil, false)
+	port, stop := startMutualTLSServer(t, ca, serverCert)
+	defer stop()
+
+	dir, err := ioutil.TempDir("", "feast-tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	caPath := writeTestFile(t, dir, "ca.pem", ca.certPEM)
+	certPath := writeTestFile(t, dir, "client.pem", clientCert.certPEM)
+	keyPath := writeTestFile(t, dir, "client.key", clientCert.keyPEM)
+
+	tt := []struct {
+		name     string
+		security SecurityConfig
+		wantCode codes.Code
+	}{
+		{
+			name: "In memory certificates",
+			security: SecurityConfig{
+				EnableTLS:     true,
+				TLSCert:       ca.certPEM,
+				TLSClientCert: clientCert.certPEM,
+				TLSClientKey:  clientCert.keyPEM,
+				TLSServerName: "feast.example",
+				TLSMinVersion: tls.VersionTLS12,
+			},
+			wantCode: codes.Unimplemented,
+		},
+		{
+			name: "Certificate files",
+			security: SecurityConfig{
+				EnableTLS:         true,
+				TLSCertPath:       caPath,
+				TLSClientCertPath: certPath,
+				TLSClientKeyPath:  keyPath,
+				TLSServerName:     "feast.example",
+				TLSReloadInterval: time.Minute,
+			},
+			wantCode: codes.Unimplemented,
+		},
+		{
+			name: "Missing client certificate",
+			security: SecurityConfig{
+				EnableTLS:     true,
+				TLSCert:       ca.certPEM,
+				TLSServerName: "feast.example",
+			},
+			wantCode: codes.Unavailable,
+		},
+		{
+			name: "Untrusted client certificate",
+			security: SecurityConfig{
+				EnableTLS:     true,
+				TLSCert:       ca.certPEM,
+				TLSClientCert: untrustedCert.certPEM,
+				TLSClientKey:  untrustedCert.keyPEM,
+				TLSServerName: "feast.example",
+			},
+			wantCode: codes.Unavailable,
+		},
+		{
+			// The certificate of the server is issued for feast.example, not for the IP address dialed.
+			name: "Reloaded certificates without server name",
+			security: SecurityConfig{
+				EnableTLS:         true,
+				TLSCertPath:       caPath,
+				TLSClientCertPath: certPath,
+				TLSClientKeyPath:  keyPath,
+				TLSReloadInterval: time.Minute,
+			},
+			wantCode: codes.Unavailable,
+		},
+		{
+			name: "Mismatched server name",
+			security: SecurityConfig{
+				EnableTLS:         true,
+				TLSCertPath:       caPath,
+				TLSClientCertPath: certPath,
+				TLSClientKeyPath:  keyPath,
+				TLSServerName:     "other.example",
+				TLSReloadInterval: time.Minute,
+			},
+			wantCode: codes.Unavailable,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cli, err := NewSecureGrpcClient("127.0.0.1", port, tc.security)
+			if err != nil {
+				t.Fatalf("Unexpected error creating client: %v", err)
+			}
+			defer cli.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_, err = cli.GetFeastServingInfo(ctx, &serving.GetFeastServingInfoRequest{})
+			if got := status.Code(err); got != tc.wantCode {
+				t.Errorf("Expected status code %v, got %v: %v", tc.wantCode, got, err)
+			}
+		})
+	}
+}
+
+func TestInvalidTLSConfig(t *testing.T) {
+	ca := newTestCert(t, "ca", nil, true)
+	clientCert := newTestCert(t, "client", ca, false)
+
+	tt := []struct {
+		name     string
+		security SecurityConfig
+	}{
+		{
+			name:     "Invalid CA certificate",
+			security: SecurityConfig{EnableTLS: true, TLSCert: []byte("not a certificate")},
+		},
+		{
+			name:     "Mismatched client key",
+			security: SecurityConfig{EnableTLS: true, TLSClientCert: clientCert.certPEM, TLSClientKey: ca.keyPEM},
+		},
+		{
+			name:     "Missing client key path",
+			security: SecurityConfig{EnableTLS: true, TLSClientCertPath: "client.pem"},
+		},
+		{
+			name:     "Missing CA certificate file",
+			security: SecurityConfig{EnableTLS: true, TLSCertPath: "does-not-exist.pem"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewSecureGrpcClient("127.0.0.1", 6566, tc.security); err == nil {
+				t.Error("Expected error creating client with invalid TLS config")
+			}
+		})
+	}
+}
+
+func TestCertificateReload(t *testing.T) {
+	ca := newTestCert(t, "ca", nil, true)
+	rotatedCA := newTestCert(t, "ca", nil, true)
+	serverCert := newTestCert(t, "feast.example", rotatedCA, false)
+	firstCert := newTestCert(t, "first", ca, false)
+	secondCert := newTestCert(t, "second", ca, false)
+
+	dir, err := ioutil.TempDir("", "feast-tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	caPath := writeTestFile(t, dir, "ca.pem", ca.certPEM)
+	certPath := writeTestFile(t, dir, "client.pem", firstCert.certPEM)
+	keyPath := writeTestFile(t, dir, "client.key", firstCert.keyPEM)
+
+	security := SecurityConfig{
+		TLSCertPath:       caPath,
+		TLSClientCertPath: certPath,
+		TLSClientKeyPath:  keyPath,
+		TLSReloadInterval: time.Nanosecond,
+	}
+	config, err := security.buildTLSConfig("feast.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rotate the client certificate.
+	clientCommonName := func() string {
+		cert, err := config.GetClientCertificate(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaf, _ := x509.ParseCertificate(cert.Certificate[0])
+		return leaf.Subject.CommonName
+	}
+	if got := clientCommonName(); got != "first" {
+		t.Errorf("Expected initial client certificate, got %s", got)
+	}
+	writeTestFile(t, dir, "client.pem", secondCert.certPEM)
+	writeTestFile(t, dir, "client.key", secondCert.keyPEM)
+	touchTestFile(t, certPath)
+	if got := clientCommonName(); got != "second" {
+		t.Errorf("Expected rotated client certificate, got %s", got)
+	}
+
+	// Rotate the CA certificate.
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{serverCert.cert}}
+	if err := config.VerifyConnection(state); err == nil {
+		t.Error("Expected server certificate signed by rotated CA to fail verification before reload")
+	}
+	writeTestFile(t, dir, "ca.pem", rotatedCA.certPEM)
+	touchTestFile(t, caPath)
+	if err := config.VerifyConnection(state); err != nil {
+		t.Errorf("Expected server certificate to pass verification after reload: %v", err)
+	}
+}